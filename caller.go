@@ -1,17 +1,15 @@
 package irys
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/http"
-	"strings"
 
+	"github.com/Ja7ad/irys/currency"
+	"github.com/Ja7ad/irys/errors"
 	"github.com/Ja7ad/irys/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/hashicorp/go-retryablehttp"
 )
 
 const (
@@ -19,231 +17,197 @@ const (
 	_uploadPath      = "%s/tx/%s"
 	_txPath          = "%s/tx/%s"
 	_downloadPath    = "%s/%s"
-	_sendTxToBalance = "%s/account/balance/matic"
-	_getBalance      = "%s/account/balance/matic?address=%s"
+	_sendTxToBalance = "%s/account/balance/%s"
+	_getBalance      = "%s/account/balance/%s?address=%s"
 	_chunkUpload     = "%s/chunks/%s/%v/%v"
 	_graphql         = "%s/graphql"
 )
 
 func (c *Client) GetPrice(ctx context.Context, fileSize int) (*big.Int, error) {
-	url := fmt.Sprintf(_pricePath, c.network, c.currency.GetName(), fileSize)
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	return c.getPrice(ctx, c.currency.GetName(), fileSize)
+}
+
+func (c *Client) getPrice(ctx context.Context, currencyName string, fileSize int) (*big.Int, error) {
+	url := fmt.Sprintf(_pricePath, c.network, currencyName, fileSize)
+	return c.sendBigInt(ctx, request{class: EndpointPrice, method: http.MethodGet, url: url})
+}
+
+func (c *Client) GetPriceDecimal(ctx context.Context, fileSize int) (string, error) {
+	price, err := c.GetPrice(ctx, fileSize)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	return currency.FormatAmount(c.currency, price), nil
+}
 
-	resp, err := c.client.Do(req)
+func (c *Client) GetBalanceDecimal(ctx context.Context) (string, error) {
+	balance, err := c.GetBalance(ctx)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer resp.Body.Close()
+	return currency.FormatAmount(c.currency, balance), nil
+}
 
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
-		if err := statusCheck(resp); err != nil {
-			return nil, err
-		}
-		return decodeBody[*big.Int](resp.Body)
+func (c *Client) TopUpBalanceDecimal(ctx context.Context, amount string) error {
+	atomic, err := currency.ParseAmount(c.currency, amount)
+	if err != nil {
+		return err
 	}
+	return c.TopUpBalance(ctx, atomic)
 }
 
 func (c *Client) GetBalance(ctx context.Context) (*big.Int, error) {
-	pbKey := c.currency.GetPublicKey()
-	url := fmt.Sprintf(_getBalance, c.network, crypto.PubkeyToAddress(*pbKey).Hex())
+	url := fmt.Sprintf(_getBalance, c.network, c.currency.GetName(), c.currency.GetAddress())
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	b, err := sendJSON[types.BalanceResponse](ctx, c, request{class: EndpointPrice, method: http.MethodGet, url: url})
 	if err != nil {
 		return nil, err
 	}
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
-		if err := statusCheck(resp); err != nil {
-			return nil, err
-		}
-		b, err := decodeBody[types.BalanceResponse](resp.Body)
-		if err != nil {
-			return nil, err
-		}
-		return b.ToBigInt(), nil
-	}
+	return b.ToBigInt(), nil
 }
 
 func (c *Client) TopUpBalance(ctx context.Context, amount *big.Int) error {
-	urlConfirm := fmt.Sprintf(_sendTxToBalance, c.network)
+	if amount == nil || amount.Sign() <= 0 {
+		return errors.ErrAmountNotPositive
+	}
 
 	hash, err := c.createTx(ctx, amount)
 	if err != nil {
 		return err
 	}
 
-	b, err := json.Marshal(&types.TxToBalanceRequest{
-		TxId: hash,
-	})
-	if err != nil {
+	if err := c.confirmTx(ctx, hash); err != nil {
 		return err
 	}
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, urlConfirm, bytes.NewBuffer(b))
+	return c.sendTxToBalance(ctx, hash)
+}
+
+func (c *Client) SpeedUpPendingTx(ctx context.Context) ([]string, error) {
+	hashes, err := c.speedUpTxs(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	for _, hash := range hashes {
+		if err := c.confirmTx(ctx, hash); err != nil {
+			return nil, err
+		}
+		if err := c.sendTxToBalance(ctx, hash); err != nil {
+			return nil, err
+		}
+	}
 
-	resp, err := c.client.Do(req)
+	return hashes, nil
+}
+
+func (c *Client) sendTxToBalance(ctx context.Context, hash string) error {
+	urlConfirm := fmt.Sprintf(_sendTxToBalance, c.network, c.currency.GetName())
+
+	b, err := json.Marshal(&types.TxToBalanceRequest{
+		TxId: hash,
+	})
 	if err != nil {
 		return err
 	}
 
-	defer resp.Body.Close()
-
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	default:
-		return statusCheck(resp)
-	}
+	return c.sendNoContent(ctx, request{
+		class:       EndpointUpload,
+		method:      http.MethodPost,
+		url:         urlConfirm,
+		body:        b,
+		contentType: "application/json",
+	})
 }
 
 func (c *Client) Download(ctx context.Context, txId string) (*types.File, error) {
 	url := fmt.Sprintf(_downloadPath, _defaultGateway, txId)
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.client.Do(req)
+	resp, err := c.send(ctx, request{method: http.MethodGet, url: url})
 	if err != nil {
 		return nil, err
 	}
 
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
-		if err := statusCheck(resp); err != nil {
-			return nil, err
-		}
-
-		return &types.File{
-			Data:          resp.Body,
-			Header:        resp.Header,
-			ContentLength: resp.ContentLength,
-			ContentType:   resp.Header.Get("Content-Type"),
-		}, nil
-	}
+	return &types.File{
+		Data:          resp.Body,
+		Header:        resp.Header,
+		ContentLength: resp.ContentLength,
+		ContentType:   resp.Header.Get("Content-Type"),
+	}, nil
 }
 
 func (c *Client) GetMetaData(ctx context.Context, txId string) (types.Transaction, error) {
 	url := fmt.Sprintf(_txPath, _defaultGateway, txId)
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	defer resp.Body.Close()
-
-	select {
-	case <-ctx.Done():
-		return types.Transaction{}, ctx.Err()
-	default:
-		if err := statusCheck(resp); err != nil {
-			return types.Transaction{}, err
-		}
-		return decodeBody[types.Transaction](resp.Body)
-	}
+	return sendJSON[types.Transaction](ctx, c, request{method: http.MethodGet, url: url})
 }
 
 func (c *Client) GetReceipt(ctx context.Context, txId string) (types.Receipt, error) {
 	url := fmt.Sprintf(_graphql, c.network)
 
-	body := strings.NewReader(fmt.Sprintf("{\"query\":\"query {\\n      "+
+	body := []byte(fmt.Sprintf("{\"query\":\"query {\\n      "+
 		"transactions(ids: [\\\"%s\\\"]) {\\n        edges {\\n         "+
 		" node {\\n            receipt {\\n              signature\\n              "+
 		"timestamp\\n              version\\n              deadlineHeight\\n           "+
 		" }\\n          }\\n        }\\n      }\\n    }\",\"variables\":{}}", txId))
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	response, err := sendJSON[types.ReceiptResponse](ctx, c, request{
+		class:       EndpointGraphql,
+		method:      http.MethodPost,
+		url:         url,
+		body:        body,
+		contentType: "application/json",
+	})
 	if err != nil {
 		return types.Receipt{}, err
 	}
 
-	req.Header.Add("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return types.Receipt{}, err
+	// graphql respond empty edges for unknown transaction
+	if len(response.Data.Transactions.Edges) == 0 {
+		return types.Receipt{}, errors.ErrNotFound
 	}
 
-	defer resp.Body.Close()
-
-	select {
-	case <-ctx.Done():
-		return types.Receipt{}, ctx.Err()
-	default:
-		if err := statusCheck(resp); err != nil {
-			return types.Receipt{}, err
-		}
-
-		response, err := decodeBody[types.ReceiptResponse](resp.Body)
-		if err != nil {
-			return types.Receipt{}, err
-		}
-
-		if response.Data.Transactions.Edges != nil {
-			return response.Data.Transactions.Edges[0].Node.Receipt, nil
-		}
-
-		return types.Receipt{}, nil
-	}
+	return response.Data.Transactions.Edges[0].Node.Receipt, nil
 }
 
 func (c *Client) BasicUpload(ctx context.Context, file []byte, tags ...types.Tag) (types.Transaction, error) {
 	url := fmt.Sprintf(_uploadPath, c.network, c.currency.GetName())
 
-	price, err := c.GetPrice(ctx, len(file))
+	if err := c.validateUpload(file, tags...); err != nil {
+		return types.Transaction{}, err
+	}
+
+	// node price signed data item, so header and tags are priced too
+	itemSize, err := dataItemSize(c.currency.GetSinger().GetType(), len(file), &quoteOptions{
+		contentType: http.DetectContentType(file),
+	}, tags...)
 	if err != nil {
 		return types.Transaction{}, err
 	}
-	c.debugMsg("[BasicUpload] get price %s", price.String())
 
-	balance, err := c.GetBalance(ctx)
+	price, err := c.GetPrice(ctx, itemSize)
 	if err != nil {
 		return types.Transaction{}, err
 	}
-	c.debugMsg("[BasicUpload] get balance %s", balance.String())
+	c.debugMsg("[BasicUpload] get price %s", price.String())
 
-	if balance.Cmp(price) < 0 {
-		err := c.TopUpBalance(ctx, price)
-		if err != nil {
-			return types.Transaction{}, err
-		}
-		c.debugMsg("[BasicUpload] topUp balance")
+	done, err := c.ensureBalance(ctx, price)
+	if err != nil {
+		return types.Transaction{}, err
 	}
+	defer done()
+	c.debugMsg("[BasicUpload] balance is funded")
 
 	return c.upload(ctx, url, file, tags...)
 }
 
 func (c *Client) Upload(ctx context.Context, file []byte, tags ...types.Tag) (types.Transaction, error) {
 	url := fmt.Sprintf(_uploadPath, c.network, c.currency.GetName())
+
+	if err := c.validateUpload(file, tags...); err != nil {
+		return types.Transaction{}, err
+	}
+
 	return c.upload(ctx, url, file, tags...)
 }
 
@@ -253,27 +217,13 @@ func (c *Client) upload(ctx context.Context, url string, file []byte, tags ...ty
 		return types.Transaction{}, err
 	}
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(b))
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	req.Header.Set("Content-Type", "application/octet-stream")
 	c.debugMsg("[Upload] create upload request")
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-	defer resp.Body.Close()
-
-	select {
-	case <-ctx.Done():
-		return types.Transaction{}, ctx.Err()
-	default:
-		if err := statusCheck(resp); err != nil {
-			return types.Transaction{}, err
-		}
-		return decodeBody[types.Transaction](resp.Body)
-	}
+	return sendJSON[types.Transaction](ctx, c, request{
+		class:       EndpointUpload,
+		method:      http.MethodPost,
+		url:         url,
+		body:        b,
+		contentType: "application/octet-stream",
+	})
 }
@@ -0,0 +1,48 @@
+package irys
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopUpBalance_NonPositive(t *testing.T) {
+	cur := newFakeCurrency(t)
+	c := newTestClient(t, http.NewServeMux(), cur)
+
+	require.ErrorIs(t, c.TopUpBalance(context.Background(), big.NewInt(-1)), errors.ErrAmountNotPositive)
+	require.ErrorIs(t, c.TopUpBalanceDecimal(context.Background(), "-1 FAKE"), errors.ErrAmountNotPositive)
+	require.Empty(t, cur.sent())
+}
+
+func TestTopUpBalance_Confirm(t *testing.T) {
+	var posted []string
+	cur := newFakeCurrency(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/account/balance/"+_test_currency, func(w http.ResponseWriter, r *http.Request) {
+		var req types.TxToBalanceRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		// node is notified after transaction is confirmed
+		cur.mu.Lock()
+		require.Contains(t, cur.confirmed, req.TxId)
+		cur.mu.Unlock()
+		posted = append(posted, req.TxId)
+	})
+	c := newTestClient(t, mux, cur)
+
+	require.NoError(t, c.TopUpBalance(context.Background(), big.NewInt(10)))
+	require.Equal(t, []string{"tx-1"}, posted)
+
+	failed := stderrors.New("reverted")
+	cur.confirmErr = failed
+	require.ErrorIs(t, c.TopUpBalance(context.Background(), big.NewInt(10)), failed)
+	require.Equal(t, []string{"tx-1"}, posted)
+}
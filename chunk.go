@@ -1,7 +1,6 @@
 package irys
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -13,7 +12,6 @@ import (
 
 	errs "github.com/Ja7ad/irys/errors"
 	"github.com/Ja7ad/irys/types"
-	"github.com/hashicorp/go-retryablehttp"
 )
 
 const (
@@ -38,6 +36,10 @@ func (c *Client) ChunkUpload(ctx context.Context, file io.Reader, chunkId string
 		return types.Transaction{}, err
 	}
 
+	if err := c.validateUpload(payload, tags...); err != nil {
+		return types.Transaction{}, err
+	}
+
 	b, err := signFile(payload, c.currency.GetSinger(), true, tags...)
 	if err != nil {
 		return types.Transaction{}, err
@@ -110,35 +112,18 @@ func (c *Client) ChunkUpload(ctx context.Context, file io.Reader, chunkId string
 		return types.Transaction{}, e
 	}
 
-	select {
-	case <-ctx.Done():
-		return types.Transaction{}, ctx.Err()
-	default:
-		return finishChunk(ctx, c, chunkUUID)
-	}
+	return finishChunk(ctx, c, chunkUUID)
 }
 
 func generateChunkID(ctx context.Context, c *Client) (types.ChunkResponse, error) {
 	url := fmt.Sprintf(_chunkUpload, c.network, c.currency.GetName(), -1, -1)
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return types.ChunkResponse{}, err
-	}
-
-	req.Header.Set("x-chunking-version", "2")
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return types.ChunkResponse{}, err
-	}
-	defer resp.Body.Close()
-
-	if err := statusCheck(resp); err != nil {
-		return types.ChunkResponse{}, err
-	}
-
-	return decodeBody[types.ChunkResponse](resp.Body)
+	return sendJSON[types.ChunkResponse](ctx, c, request{
+		class:  EndpointChunk,
+		method: http.MethodGet,
+		url:    url,
+		header: map[string]string{"x-chunking-version": "2"},
+	})
 }
 
 func getChunkID(ctx context.Context, c *Client, chunkId string) (types.ChunkInfoResponse, error) {
@@ -177,56 +162,26 @@ func worker(ctx context.Context, c *Client, id int, wg *sync.WaitGroup, jobs <-c
 func createChunkRequest(ctx context.Context, c *Client, chunk types.Chunk, index, workerID int) error {
 	url := fmt.Sprintf(_chunkUpload, c.network, c.currency.GetName(), chunk.ID, chunk.Offset)
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(chunk.Data))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Content-Type", "application/octet-stream")
-	req.Header.Set("x-chunking-version", "2")
+	c.debugMsg("[ChunkUpload] worker %d do request for chunk %d", workerID, index)
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return err
-	}
-
-	defer resp.Body.Close()
-
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	default:
-		c.debugMsg("[ChunkUpload] worker %d do request for chunk %d", workerID, index)
-		return statusCheck(resp)
-	}
+	return c.sendNoContent(ctx, request{
+		class:       EndpointChunk,
+		method:      http.MethodPost,
+		url:         url,
+		body:        chunk.Data,
+		contentType: "application/octet-stream",
+		header:      map[string]string{"x-chunking-version": "2"},
+	})
 }
 
 func finishChunk(ctx context.Context, c *Client, uuid string) (types.Transaction, error) {
 	url := fmt.Sprintf(_chunkUpload, c.network, c.currency.GetName(), uuid, -1)
 
-	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, url, nil)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	req.Header.Set("Content-Type", "application/octet-stream")
-	req.Header.Set("x-chunking-version", "2")
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return types.Transaction{}, err
-	}
-
-	defer resp.Body.Close()
-
-	select {
-	case <-ctx.Done():
-		return types.Transaction{}, ctx.Err()
-	default:
-		if err := statusCheck(resp); err != nil {
-			return types.Transaction{}, err
-		}
-
-		return decodeBody[types.Transaction](resp.Body)
-	}
+	return sendJSON[types.Transaction](ctx, c, request{
+		class:       EndpointChunk,
+		method:      http.MethodPost,
+		url:         url,
+		contentType: "application/octet-stream",
+		header:      map[string]string{"x-chunking-version": "2"},
+	})
 }
@@ -0,0 +1,289 @@
+package currency
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+)
+
+const (
+	_algorand_name   = "algorand"
+	_algorand_chain  = "algorand"
+	_algorand_symbol = "algo"
+	// 1 algo is 10^6 microalgos
+	_algorand_decimals = 6
+
+	_algorand_valid_rounds   = 1000
+	_algorand_signature_size = 75 // size of signature field in signed transaction
+	_algorand_tx_prefix      = "TX"
+	_algorand_binary_type    = "application/x-binary"
+)
+
+type Algorand struct {
+	chain     string
+	symbol    string
+	name      string
+	rpc       string
+	tokenType CurrencyType
+	signer    *signer.AlgorandSigner
+}
+
+// NewAlgorand create algorand currency object by base64 private key and algod api address
+func NewAlgorand(privateKey, rpc string) (Currency, error) {
+	if len(privateKey) == 0 {
+		return nil, errors.ErrPrivateKeyIsEmpty
+	}
+
+	s, err := signer.NewAlgorandSigner(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Algorand{
+		chain:     _algorand_chain,
+		symbol:    _algorand_symbol,
+		name:      _algorand_name,
+		rpc:       strings.TrimSuffix(rpc, "/"),
+		tokenType: ALGORAND,
+		signer:    s,
+	}, nil
+}
+
+func (a *Algorand) GetChain() string {
+	return a.chain
+}
+
+func (a *Algorand) GetSymbol() string {
+	return a.symbol
+}
+
+func (a *Algorand) GetName() string {
+	return a.name
+}
+
+func (a *Algorand) Decimals() int {
+	return _algorand_decimals
+}
+
+func (a *Algorand) GetAddress() string {
+	return a.signer.Address()
+}
+
+func (a *Algorand) GetSinger() signer.Signer {
+	return a.signer
+}
+
+func (a *Algorand) GetRPCAddr() string {
+	return a.rpc
+}
+
+func (a *Algorand) GetType() CurrencyType {
+	return a.tokenType
+}
+
+// SendFunds transfer amount microalgos to address with payment transaction and return transaction id
+func (a *Algorand) SendFunds(ctx context.Context, to string, amount *big.Int) (string, error) {
+	if len(a.rpc) == 0 {
+		return "", errors.ErrSignOnlyCurrency
+	}
+
+	receiver, err := signer.DecodeAlgorandAddress(to)
+	if err != nil {
+		return "", err
+	}
+
+	if amount == nil || amount.Sign() <= 0 {
+		return "", errors.ErrAmountNotPositive
+	}
+	if !amount.IsUint64() {
+		return "", errors.ErrInvalidAmount
+	}
+
+	var params struct {
+		Fee         uint64 `json:"fee"`
+		MinFee      uint64 `json:"min-fee"`
+		LastRound   uint64 `json:"last-round"`
+		GenesisID   string `json:"genesis-id"`
+		GenesisHash string `json:"genesis-hash"`
+	}
+	if err := restCall(ctx, http.MethodGet, a.rpc+"/v2/transactions/params", "", nil, &params); err != nil {
+		return "", err
+	}
+
+	genesisHash, err := base64.StdEncoding.DecodeString(params.GenesisHash)
+	if err != nil {
+		return "", err
+	}
+
+	payment := algorandPayment{
+		amount:      amount.Uint64(),
+		firstValid:  params.LastRound,
+		lastValid:   params.LastRound + _algorand_valid_rounds,
+		genesisID:   params.GenesisID,
+		genesisHash: genesisHash,
+		receiver:    receiver,
+		sender:      a.signer.Owner,
+	}
+
+	// fee is per byte of signed transaction, but not lower than min fee
+	payment.fee = params.Fee * uint64(len(payment.encode())+_algorand_signature_size)
+	if payment.fee < params.MinFee {
+		payment.fee = params.MinFee
+	}
+
+	txn := payment.encode()
+	signature := ed25519.Sign(a.signer.PrivateKey, append([]byte(_algorand_tx_prefix), txn...))
+
+	signedTx := msgpackMapHeader(2)
+	signedTx = append(signedTx, msgpackString("sig")...)
+	signedTx = append(signedTx, msgpackBinary(signature)...)
+	signedTx = append(signedTx, msgpackString("txn")...)
+	signedTx = append(signedTx, txn...)
+
+	var result struct {
+		TxId string `json:"txId"`
+	}
+	if err := restCall(ctx, http.MethodPost, a.rpc+"/v2/transactions", _algorand_binary_type, signedTx, &result); err != nil {
+		return "", err
+	}
+
+	return result.TxId, nil
+}
+
+type algorandPayment struct {
+	amount      uint64
+	fee         uint64
+	firstValid  uint64
+	lastValid   uint64
+	genesisID   string
+	genesisHash []byte
+	receiver    []byte
+	sender      []byte
+}
+
+// encode payment transaction as canonical msgpack, keys are sorted and empty values are omitted
+func (p algorandPayment) encode() []byte {
+	type field struct {
+		key   string
+		value []byte
+	}
+
+	fields := []field{
+		{"amt", msgpackUint(p.amount)},
+		{"fee", msgpackUint(p.fee)},
+		{"fv", msgpackUint(p.firstValid)},
+		{"gen", msgpackString(p.genesisID)},
+		{"gh", msgpackBinary(p.genesisHash)},
+		{"lv", msgpackUint(p.lastValid)},
+		{"rcv", msgpackBinary(p.receiver)},
+		{"snd", msgpackBinary(p.sender)},
+		{"type", msgpackString("pay")},
+	}
+
+	var out []byte
+	count := 0
+	for _, f := range fields {
+		if isMsgpackEmpty(f.value) {
+			continue
+		}
+		out = append(out, msgpackString(f.key)...)
+		out = append(out, f.value...)
+		count++
+	}
+
+	return append(msgpackMapHeader(count), out...)
+}
+
+// isMsgpackEmpty check encoded value is zero uint, empty string or empty binary
+func isMsgpackEmpty(value []byte) bool {
+	return len(value) == 1 && value[0] == 0x00 || // 0
+		len(value) == 1 && value[0] == 0xa0 || // ""
+		len(value) == 2 && value[0] == 0xc4 && value[1] == 0x00 // []byte{}
+}
+
+func msgpackMapHeader(n int) []byte {
+	// fixmap, algorand transactions never have more than 15 fields
+	return []byte{0x80 | byte(n)}
+}
+
+func msgpackUint(n uint64) []byte {
+	switch {
+	case n < 0x80:
+		return []byte{byte(n)}
+	case n <= 0xff:
+		return []byte{0xcc, byte(n)}
+	case n <= 0xffff:
+		b := []byte{0xcd, 0, 0}
+		binary.BigEndian.PutUint16(b[1:], uint16(n))
+		return b
+	case n <= 0xffffffff:
+		b := []byte{0xce, 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(b[1:], uint32(n))
+		return b
+	default:
+		b := make([]byte, 9)
+		b[0] = 0xcf
+		binary.BigEndian.PutUint64(b[1:], n)
+		return b
+	}
+}
+
+func msgpackString(s string) []byte {
+	switch {
+	case len(s) < 32:
+		return append([]byte{0xa0 | byte(len(s))}, s...)
+	default:
+		return append([]byte{0xd9, byte(len(s))}, s...)
+	}
+}
+
+func msgpackBinary(b []byte) []byte {
+	return append([]byte{0xc4, byte(len(b))}, b...)
+}
+
+// GetWalletBalance return balance of account in microalgos
+func (a *Algorand) GetWalletBalance(ctx context.Context) (*big.Int, error) {
+	if len(a.rpc) == 0 {
+		return nil, errors.ErrSignOnlyCurrency
+	}
+
+	var account struct {
+		Amount uint64 `json:"amount"`
+	}
+	if err := restCall(ctx, http.MethodGet, a.rpc+"/v2/accounts/"+a.GetAddress(), "", nil, &account); err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetUint64(account.Amount), nil
+}
+
+// WaitForConfirmation wait until transaction is confirmed in a round, rejected transaction return error
+func (a *Algorand) WaitForConfirmation(ctx context.Context, txId string) error {
+	if len(a.rpc) == 0 {
+		return errors.ErrSignOnlyCurrency
+	}
+
+	return pollConfirmation(ctx, func() (bool, error) {
+		var pending struct {
+			ConfirmedRound uint64 `json:"confirmed-round"`
+			PoolError      string `json:"pool-error"`
+		}
+		if err := restCall(ctx, http.MethodGet, a.rpc+"/v2/transactions/pending/"+txId, "", nil, &pending); err != nil {
+			return false, err
+		}
+
+		if len(pending.PoolError) != 0 {
+			return false, fmt.Errorf("algorand transaction %s rejected: %s", txId, pending.PoolError)
+		}
+
+		return pending.ConfirmedRound > 0, nil
+	})
+}
@@ -0,0 +1,50 @@
+package currency
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlgorandPaymentEncode(t *testing.T) {
+	payment := algorandPayment{
+		amount:      1000,
+		fee:         1000,
+		firstValid:  100,
+		lastValid:   1100,
+		genesisID:   "mainnet-v1.0",
+		genesisHash: make([]byte, 32),
+		receiver:    make([]byte, 32),
+		sender:      make([]byte, 32),
+	}
+
+	encoded := payment.encode()
+	require.Equal(t, byte(0x89), encoded[0])
+	require.Equal(t, append([]byte{0xa3}, "amt"...), encoded[1:5])
+	require.Equal(t, []byte{0xcd, 0x03, 0xe8}, encoded[5:8])
+
+	// zero values are omitted
+	payment.amount = 0
+	require.Equal(t, byte(0x88), payment.encode()[0])
+}
+
+func TestMsgpackUint(t *testing.T) {
+	require.Equal(t, []byte{0x7f}, msgpackUint(0x7f))
+	require.Equal(t, []byte{0xcc, 0x80}, msgpackUint(0x80))
+	require.Equal(t, []byte{0xce, 0x00, 0x01, 0x00, 0x00}, msgpackUint(0x10000))
+	require.Equal(t, []byte{0xcf, 0, 0, 0, 1, 0, 0, 0, 0}, msgpackUint(0x100000000))
+}
+
+func TestAlgorand_SendFundsNonPositive(t *testing.T) {
+	c, err := NewAlgorand("AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA=", "http://127.0.0.1:1")
+	require.NoError(t, err)
+	algorand := c.(*Algorand)
+
+	for _, amount := range []*big.Int{nil, big.NewInt(0), big.NewInt(-1)} {
+		_, err := algorand.SendFunds(context.Background(), algorand.GetAddress(), amount)
+		require.ErrorIs(t, err, errors.ErrAmountNotPositive)
+	}
+}
@@ -0,0 +1,298 @@
+package currency
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	_aptos_name   = "aptos"
+	_aptos_chain  = "aptos"
+	_aptos_symbol = "apt"
+	// 1 apt is 10^8 octas
+	_aptos_decimals = 8
+
+	_aptos_max_gas_amount     = 2000
+	_aptos_expiration         = 10 * time.Minute
+	_aptos_signed_tx_type     = "application/x.aptos.signed_transaction+bcs"
+	_aptos_raw_tx_salt        = "APTOS::RawTransaction"
+	_aptos_entry_function     = 2 // TransactionPayload::EntryFunction
+	_aptos_ed25519_authorizer = 0 // TransactionAuthenticator::Ed25519
+)
+
+type Aptos struct {
+	chain     string
+	symbol    string
+	name      string
+	rpc       string
+	tokenType CurrencyType
+	signer    *signer.AptosSigner
+}
+
+// NewAptos create aptos currency object by hex private key and rest api address of fullnode (e.g. https://fullnode.mainnet.aptoslabs.com/v1)
+func NewAptos(privateKey, rpc string) (Currency, error) {
+	if len(privateKey) == 0 {
+		return nil, errors.ErrPrivateKeyIsEmpty
+	}
+
+	s, err := signer.NewAptosSigner(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Aptos{
+		chain:     _aptos_chain,
+		symbol:    _aptos_symbol,
+		name:      _aptos_name,
+		rpc:       strings.TrimSuffix(rpc, "/"),
+		tokenType: APTOS,
+		signer:    s,
+	}, nil
+}
+
+func (a *Aptos) GetChain() string {
+	return a.chain
+}
+
+func (a *Aptos) GetSymbol() string {
+	return a.symbol
+}
+
+func (a *Aptos) GetName() string {
+	return a.name
+}
+
+func (a *Aptos) Decimals() int {
+	return _aptos_decimals
+}
+
+func (a *Aptos) GetAddress() string {
+	return a.signer.Address()
+}
+
+func (a *Aptos) GetSinger() signer.Signer {
+	return a.signer
+}
+
+func (a *Aptos) GetRPCAddr() string {
+	return a.rpc
+}
+
+func (a *Aptos) GetType() CurrencyType {
+	return a.tokenType
+}
+
+// SendFunds transfer amount octas to address with 0x1::aptos_account::transfer and return transaction hash
+func (a *Aptos) SendFunds(ctx context.Context, to string, amount *big.Int) (string, error) {
+	if len(a.rpc) == 0 {
+		return "", errors.ErrSignOnlyCurrency
+	}
+
+	toAddress, err := aptosAddress(to)
+	if err != nil {
+		return "", err
+	}
+
+	if amount == nil || amount.Sign() <= 0 {
+		return "", errors.ErrAmountNotPositive
+	}
+	if !amount.IsUint64() {
+		return "", errors.ErrInvalidAmount
+	}
+
+	sender, err := aptosAddress(a.signer.Address())
+	if err != nil {
+		return "", err
+	}
+
+	var ledger struct {
+		ChainID uint8 `json:"chain_id"`
+	}
+	if err := restCall(ctx, http.MethodGet, a.rpc, "", nil, &ledger); err != nil {
+		return "", err
+	}
+
+	var account struct {
+		SequenceNumber string `json:"sequence_number"`
+	}
+	if err := restCall(ctx, http.MethodGet, a.rpc+"/accounts/"+a.signer.Address(), "", nil, &account); err != nil {
+		return "", err
+	}
+	sequence, err := strconv.ParseUint(account.SequenceNumber, 10, 64)
+	if err != nil {
+		return "", err
+	}
+
+	var gas struct {
+		GasEstimate uint64 `json:"gas_estimate"`
+	}
+	if err := restCall(ctx, http.MethodGet, a.rpc+"/estimate_gas_price", "", nil, &gas); err != nil {
+		return "", err
+	}
+
+	rawTx := aptosTransferTransaction(
+		sender,
+		toAddress,
+		sequence,
+		amount.Uint64(),
+		gas.GasEstimate,
+		uint64(time.Now().Add(_aptos_expiration).Unix()),
+		ledger.ChainID,
+	)
+
+	salt := sha3.Sum256([]byte(_aptos_raw_tx_salt))
+	signature := ed25519.Sign(a.signer.PrivateKey, append(salt[:], rawTx...))
+
+	signedTx := append(rawTx, bcsUleb128(_aptos_ed25519_authorizer)...)
+	signedTx = append(signedTx, bcsBytes(a.signer.Owner)...)
+	signedTx = append(signedTx, bcsBytes(signature)...)
+
+	var pending struct {
+		Hash string `json:"hash"`
+	}
+	if err := restCall(ctx, http.MethodPost, a.rpc+"/transactions", _aptos_signed_tx_type, signedTx, &pending); err != nil {
+		return "", err
+	}
+
+	return pending.Hash, nil
+}
+
+// aptosTransferTransaction build bcs serialized raw transaction of 0x1::aptos_account::transfer
+func aptosTransferTransaction(sender, to []byte, sequence, amount, gasUnitPrice, expiration uint64, chainID uint8) []byte {
+	framework := make([]byte, 32)
+	framework[31] = 0x1
+
+	tx := append([]byte{}, sender...)
+	tx = append(tx, bcsU64(sequence)...)
+
+	tx = append(tx, bcsUleb128(_aptos_entry_function)...)
+	tx = append(tx, framework...)
+	tx = append(tx, bcsBytes([]byte("aptos_account"))...)
+	tx = append(tx, bcsBytes([]byte("transfer"))...)
+	tx = append(tx, bcsUleb128(0)...) // type arguments
+	tx = append(tx, bcsUleb128(2)...) // arguments
+	tx = append(tx, bcsBytes(to)...)
+	tx = append(tx, bcsBytes(bcsU64(amount))...)
+
+	tx = append(tx, bcsU64(_aptos_max_gas_amount)...)
+	tx = append(tx, bcsU64(gasUnitPrice)...)
+	tx = append(tx, bcsU64(expiration)...)
+	tx = append(tx, chainID)
+
+	return tx
+}
+
+// aptosAddress parse hex aptos address, short addresses are left padded to 32 bytes
+func aptosAddress(address string) ([]byte, error) {
+	address = strings.TrimPrefix(address, "0x")
+	if len(address) == 0 || len(address) > 64 {
+		return nil, errors.ErrInvalidAddress
+	}
+	if len(address)%2 != 0 {
+		address = "0" + address
+	}
+
+	b, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, errors.ErrInvalidAddress
+	}
+
+	return append(make([]byte, 32-len(b)), b...), nil
+}
+
+func bcsU64(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, n)
+	return b
+}
+
+func bcsUleb128(n uint64) []byte {
+	var out []byte
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func bcsBytes(b []byte) []byte {
+	return append(bcsUleb128(uint64(len(b))), b...)
+}
+
+// GetWalletBalance return balance of account in octas with 0x1::coin::balance view function
+func (a *Aptos) GetWalletBalance(ctx context.Context) (*big.Int, error) {
+	if len(a.rpc) == 0 {
+		return nil, errors.ErrSignOnlyCurrency
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"function":       "0x1::coin::balance",
+		"type_arguments": []string{"0x1::aptos_coin::AptosCoin"},
+		"arguments":      []string{a.signer.Address()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	if err := restCall(ctx, http.MethodPost, a.rpc+"/view", "application/json", body, &result); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("aptos view function returned empty result")
+	}
+
+	balance, ok := new(big.Int).SetString(result[0], 10)
+	if !ok {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	return balance, nil
+}
+
+// WaitForConfirmation wait until transaction is committed, failed transaction return error
+func (a *Aptos) WaitForConfirmation(ctx context.Context, txId string) error {
+	if len(a.rpc) == 0 {
+		return errors.ErrSignOnlyCurrency
+	}
+
+	return pollConfirmation(ctx, func() (bool, error) {
+		var tx struct {
+			Type     string `json:"type"`
+			Success  bool   `json:"success"`
+			VMStatus string `json:"vm_status"`
+		}
+		err := restCall(ctx, http.MethodGet, a.rpc+"/transactions/by_hash/"+txId, "", nil, &tx)
+		if isNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if tx.Type == "pending_transaction" {
+			return false, nil
+		}
+		if !tx.Success {
+			return false, fmt.Errorf("aptos transaction %s failed: %s", txId, tx.VMStatus)
+		}
+
+		return true, nil
+	})
+}
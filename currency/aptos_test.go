@@ -0,0 +1,44 @@
+package currency
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAptosAddress(t *testing.T) {
+	address, err := aptosAddress("0x1")
+	require.NoError(t, err)
+	require.Len(t, address, 32)
+	require.Equal(t, byte(1), address[31])
+
+	_, err = aptosAddress("0xzz")
+	require.ErrorIs(t, err, errors.ErrInvalidAddress)
+}
+
+func TestAptosTransferTransaction(t *testing.T) {
+	sender, _ := aptosAddress("0xa")
+	to, _ := aptosAddress("0xb")
+
+	tx := aptosTransferTransaction(sender, to, 1, 100, 100, 1700000000, 1)
+
+	// sender + sequence + payload (variant, module address, names, args) + gas + expiration + chain id
+	require.Len(t, tx, 32+8+1+32+14+9+1+1+33+9+8+8+8+1)
+	require.Equal(t, sender, tx[:32])
+	require.Equal(t, byte(1), tx[len(tx)-1])
+	require.Equal(t, []byte{0x80, 0x01}, bcsUleb128(128))
+}
+
+func TestAptos_SendFundsNonPositive(t *testing.T) {
+	c, err := NewAptos("0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20", "http://127.0.0.1:1")
+	require.NoError(t, err)
+	aptos := c.(*Aptos)
+
+	for _, amount := range []*big.Int{nil, big.NewInt(0), big.NewInt(-1)} {
+		_, err := aptos.SendFunds(context.Background(), "0x1", amount)
+		require.ErrorIs(t, err, errors.ErrAmountNotPositive)
+	}
+}
@@ -11,10 +11,11 @@ const (
 	_arweave_name   = "arweave"
 	_arweave_chain  = "arweave"
 	_arweave_symbol = "ar"
+	// 1 ar is 10^12 winston
+	_arweave_decimals = 12
 )
 
 type Arweave struct {
-	unimplementedEther
 	chain     string
 	symbol    string
 	name      string
@@ -23,7 +24,7 @@ type Arweave struct {
 	signer    *signer.ArweaveSigner
 }
 
-// NewArweaveFromFile create token object for arweave by private key file arweave (not supported for TopUp Balance)
+// NewArweaveFromFile create token object for arweave by private key file, JWK or PEM (not supported for TopUp Balance)
 func NewArweaveFromFile(filePath, rpc string) (Currency, error) {
 	privateKey, err := os.ReadFile(filePath)
 	if err != nil {
@@ -45,7 +46,7 @@ func NewArweaveFromFile(filePath, rpc string) (Currency, error) {
 	}, nil
 }
 
-// NewArweave create token object from arweave private key payload  (not supported for TopUp Balance)
+// NewArweave create token object from arweave private key payload, JWK or PEM (not supported for TopUp Balance)
 func NewArweave(privateKey string) (Currency, error) {
 	if len(privateKey) == 0 {
 		return nil, errors.ErrPrivateKeyIsEmpty
@@ -76,6 +77,11 @@ func (a *Arweave) GetName() string {
 	return a.name
 }
 
+// GetAddress return arweave wallet address (base64url sha256 of public key modulus)
+func (a *Arweave) GetAddress() string {
+	return a.signer.Address()
+}
+
 func (a *Arweave) GetSinger() signer.Signer {
 	return a.signer
 }
@@ -84,6 +90,10 @@ func (a *Arweave) GetRPCAddr() string {
 	return a.rpc
 }
 
+func (a *Arweave) Decimals() int {
+	return _arweave_decimals
+}
+
 func (a *Arweave) GetType() CurrencyType {
 	return a.tokenType
 }
@@ -0,0 +1,61 @@
+package currency
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+// _bip39_english is english wordlist of bip-39
+// https://github.com/bitcoin/bips/blob/master/bip-0039/english.txt
+//
+//go:embed bip39_english.txt
+var _bip39_english string
+
+var bip39Words = func() map[string]int64 {
+	words := strings.Fields(_bip39_english)
+	index := make(map[string]int64, len(words))
+	for i, word := range words {
+		index[word] = int64(i)
+	}
+	return index
+}()
+
+// mnemonicWords normalize mnemonic (NFKD) and check its words are in english wordlist and checksum is valid,
+// see https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki
+func mnemonicWords(mnemonic string) ([]string, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return nil, errors.ErrInvalidMnemonic
+	}
+
+	// each word is 11 bits of entropy and checksum, checksum is 1 bit for each 3 words
+	bits := new(big.Int)
+	for i, word := range words {
+		index, ok := bip39Words[word]
+		if !ok {
+			// mnemonic is secret, so position of word is reported instead of word
+			return nil, fmt.Errorf("%w: word %d", errors.ErrMnemonicUnknownWord, i+1)
+		}
+		bits.Lsh(bits, 11).Or(bits, big.NewInt(index))
+	}
+
+	checksumBits := uint(len(words) / 3)
+	checksum := new(big.Int).And(bits, big.NewInt(1<<checksumBits-1)).Uint64()
+	entropy := new(big.Int).Rsh(bits, checksumBits).FillBytes(make([]byte, len(words)*4/3))
+	defer zeroBytes(entropy)
+
+	hash := sha256.Sum256(entropy)
+	if uint64(hash[0]>>(8-checksumBits)) != checksum {
+		return nil, errors.ErrMnemonicChecksum
+	}
+
+	return words, nil
+}
@@ -1,13 +1,18 @@
 package currency
 
 import (
-	"crypto/ecdsa"
+	"context"
+	"math/big"
+	"time"
 
 	"github.com/Ja7ad/irys/signer"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
-const _0x_prefix = "0x"
+const (
+	_0x_prefix = "0x"
+
+	_confirmation_poll_interval = 2 * time.Second
+)
 
 type CurrencyType uint8
 
@@ -19,36 +24,69 @@ const (
 	AVALANCHE
 	FANTOM
 	ARWEAVE
+	USDC_ETHEREUM
+	USDC_POLYGON
+	SOLANA
+	APTOS
+	NEAR
+	ALGORAND
+	EVM // EVM is currency of custom evm chain created by NewEVM
 )
 
+// Currency is currency used by irys node for pricing, balance and signing data items
 type Currency interface {
-	Ether
 	GetName() string
 	GetChain() string
 	GetSymbol() string
+	// Decimals return number of decimals between currency unit and atomic unit (e.g. 18 for wei)
+	Decimals() int
+	// GetAddress return wallet address of currency used by irys node for balance
+	GetAddress() string
 	GetSinger() signer.Signer
 	GetRPCAddr() string
-	GetType() CurrencyType
 }
 
-type Ether interface {
-	GetRPCClient() *ethclient.Client
-	GetPrivateKey() *ecdsa.PrivateKey
-	GetPublicKey() *ecdsa.PublicKey
+// Funder is wallet of chain used for top up balance of irys node, it's implemented by currencies
+// which support funding and is separate from data item signer
+type Funder interface {
+	// GetAddress return address of wallet which send funds
+	GetAddress() string
+	// GetWalletBalance return on-chain balance of wallet in atomic unit
+	GetWalletBalance(ctx context.Context) (*big.Int, error)
+	// SendFunds transfer amount in atomic unit to address and return transaction id
+	SendFunds(ctx context.Context, to string, amount *big.Int) (string, error)
+	// WaitForConfirmation block until transaction is confirmed or failed
+	WaitForConfirmation(ctx context.Context, txId string) error
 }
 
-type unimplementedEther struct{}
-
-var _ Ether = (*unimplementedEther)(nil)
-
-func (u unimplementedEther) GetRPCClient() *ethclient.Client {
-	panic("implement me")
+// PendingReplacer is implemented by funders which can rebroadcast stuck transactions with higher fee
+type PendingReplacer interface {
+	// SpeedUpPending replace pending transactions and return id of replacement transactions
+	SpeedUpPending(ctx context.Context) ([]string, error)
 }
 
-func (u unimplementedEther) GetPrivateKey() *ecdsa.PrivateKey {
-	panic("implement me")
+// EVMCurrency is implemented by currencies of ethereum virtual machine chains
+type EVMCurrency interface {
+	Currency
+	Funder
+	GetChainID(ctx context.Context) (*big.Int, error)
 }
 
-func (u unimplementedEther) GetPublicKey() *ecdsa.PublicKey {
-	panic("implement me")
+// pollConfirmation call check every interval until it return done or error
+func pollConfirmation(ctx context.Context, check func() (done bool, err error)) error {
+	ticker := time.NewTicker(_confirmation_poll_interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
 }
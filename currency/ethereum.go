@@ -1,264 +1,241 @@
 package currency
 
 import (
+	"context"
 	"crypto/ecdsa"
+	stderrors "errors"
+	"io"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
 
 	"github.com/Ja7ad/irys/errors"
 	"github.com/Ja7ad/irys/signer"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
+const (
+	_evm_decimals  = 18
+	_usdc_decimals = 6
+
+	_usdc_ethereum_contract = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	_usdc_polygon_contract  = "0x3c499c542cEF5E3811e1192ce70d8cC03d5c3359"
+)
+
+// EVMConfig is metadata of ethereum virtual machine currency
+type EVMConfig struct {
+	Name          string   // Name is currency name in irys node (e.g. base-eth)
+	Chain         string   // Chain is name of chain
+	Symbol        string   // Symbol of currency
+	Decimals      int      // Decimals of currency, default is 18
+	ChainID       *big.Int // ChainID of network, fetched from rpc when it's nil
+	TokenContract string   // TokenContract is address of erc-20 contract, empty for native coin
+}
+
 type Ethereum struct {
+	mu         sync.Mutex
 	chain      string
 	symbol     string
 	name       string
 	rpc        string
 	tokenType  CurrencyType
-	client     *ethclient.Client
-	privateKey *ecdsa.PrivateKey
-	publicKey  *ecdsa.PublicKey
-	signer     *signer.EthereumSigner
+	decimals   int
+	contract   string
+	chainID    *big.Int
+	clientMu   sync.Mutex
+	client     *ethclient.Client // client is dialled on first use, see rpcClient
+	nonces     *NonceManager
+	privateKey *ecdsa.PrivateKey // privateKey is nil when signer is external
+	address    common.Address
+	signer     signer.Signer
+	txSigner   TxSigner
 }
 
 // NewEthereum create ethereum currency object
 func NewEthereum(privateKey, rpc string) (Currency, error) {
-	if len(privateKey) == 0 {
-		return nil, errors.ErrPrivateKeyIsEmpty
-	}
-
-	s, err := signer.NewEthereumSigner(_0x_prefix + privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := ethclient.Dial(rpc)
-	if err != nil {
-		return nil, err
-	}
-
-	prKey, err := crypto.HexToECDSA(privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	pbKey := prKey.Public()
-	publicKeyECDSA, ok := pbKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, errors.ErrAssertionPublicKey
-	}
-
-	return &Ethereum{
-		name:       "ethereum",
-		chain:      "ethereum",
-		symbol:     "eth",
-		signer:     s,
-		rpc:        rpc,
-		tokenType:  ETHEREUM,
-		client:     client,
-		privateKey: prKey,
-		publicKey:  publicKeyECDSA,
-	}, nil
+	return newEVM(EVMConfig{
+		Name:     "ethereum",
+		Chain:    "ethereum",
+		Symbol:   "eth",
+		Decimals: _evm_decimals,
+		ChainID:  big.NewInt(1),
+	}, ETHEREUM, privateKey, rpc)
 }
 
 // NewMatic create matic object currency
 func NewMatic(privateKey, rpc string) (Currency, error) {
-	if len(privateKey) == 0 {
-		return nil, errors.ErrPrivateKeyIsEmpty
-	}
-
-	s, err := signer.NewEthereumSigner(_0x_prefix + privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := ethclient.Dial(rpc)
-	if err != nil {
-		return nil, err
-	}
-
-	prKey, err := crypto.HexToECDSA(privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	pbKey := prKey.Public()
-	publicKeyECDSA, ok := pbKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, errors.ErrAssertionPublicKey
-	}
-
-	return &Ethereum{
-		name:       "matic",
-		chain:      "polygon",
-		symbol:     "matic",
-		signer:     s,
-		tokenType:  MATIC,
-		rpc:        rpc,
-		client:     client,
-		privateKey: prKey,
-		publicKey:  publicKeyECDSA,
-	}, nil
+	return newEVM(EVMConfig{
+		Name:     "matic",
+		Chain:    "polygon",
+		Symbol:   "matic",
+		Decimals: _evm_decimals,
+		ChainID:  big.NewInt(137),
+	}, MATIC, privateKey, rpc)
 }
 
 // NewBNB create bnb object currency
 func NewBNB(privateKey, rpc string) (Currency, error) {
-	if len(privateKey) == 0 {
-		return nil, errors.ErrPrivateKeyIsEmpty
-	}
-
-	s, err := signer.NewEthereumSigner(_0x_prefix + privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := ethclient.Dial(rpc)
-	if err != nil {
-		return nil, err
-	}
-
-	prKey, err := crypto.HexToECDSA(privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	pbKey := prKey.Public()
-	publicKeyECDSA, ok := pbKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, errors.ErrAssertionPublicKey
-	}
-
-	return &Ethereum{
-		name:       "bnb",
-		chain:      "binance",
-		symbol:     "bnb",
-		signer:     s,
-		tokenType:  BNB,
-		rpc:        rpc,
-		client:     client,
-		privateKey: prKey,
-		publicKey:  publicKeyECDSA,
-	}, nil
+	return newEVM(EVMConfig{
+		Name:     "bnb",
+		Chain:    "binance",
+		Symbol:   "bnb",
+		Decimals: _evm_decimals,
+		ChainID:  big.NewInt(56),
+	}, BNB, privateKey, rpc)
 }
 
 // NewArbitrum create arbitrum object currency
 func NewArbitrum(privateKey, rpc string) (Currency, error) {
-	if len(privateKey) == 0 {
-		return nil, errors.ErrPrivateKeyIsEmpty
-	}
+	return newEVM(EVMConfig{
+		Name:     "arbitrum",
+		Chain:    "arbitrum",
+		Symbol:   "arb",
+		Decimals: _evm_decimals,
+		ChainID:  big.NewInt(42161),
+	}, ARBITRUM, privateKey, rpc)
+}
 
-	s, err := signer.NewEthereumSigner(_0x_prefix + privateKey)
-	if err != nil {
-		return nil, err
-	}
+// NewAvalanche create avalanche object currency
+func NewAvalanche(privateKey, rpc string) (Currency, error) {
+	return newEVM(EVMConfig{
+		Name:     "avalanche",
+		Chain:    "avalanche",
+		Symbol:   "avax",
+		Decimals: _evm_decimals,
+		ChainID:  big.NewInt(43114),
+	}, AVALANCHE, privateKey, rpc)
+}
 
-	client, err := ethclient.Dial(rpc)
-	if err != nil {
-		return nil, err
-	}
+// NewFantom create fantom object currency
+func NewFantom(privateKey, rpc string) (Currency, error) {
+	return newEVM(EVMConfig{
+		Name:     "fantom",
+		Chain:    "fantom",
+		Symbol:   "ftm",
+		Decimals: _evm_decimals,
+		ChainID:  big.NewInt(250),
+	}, FANTOM, privateKey, rpc)
+}
 
-	prKey, err := crypto.HexToECDSA(privateKey)
-	if err != nil {
-		return nil, err
-	}
+// NewUSDCEthereum create usdc (erc-20) on ethereum object currency
+func NewUSDCEthereum(privateKey, rpc string) (Currency, error) {
+	return newEVM(EVMConfig{
+		Name:          "usdc-eth",
+		Chain:         "ethereum",
+		Symbol:        "usdc",
+		Decimals:      _usdc_decimals,
+		ChainID:       big.NewInt(1),
+		TokenContract: _usdc_ethereum_contract,
+	}, USDC_ETHEREUM, privateKey, rpc)
+}
 
-	pbKey := prKey.Public()
-	publicKeyECDSA, ok := pbKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, errors.ErrAssertionPublicKey
-	}
+// NewUSDCPolygon create usdc (erc-20) on polygon object currency
+func NewUSDCPolygon(privateKey, rpc string) (Currency, error) {
+	return newEVM(EVMConfig{
+		Name:          "usdc-polygon",
+		Chain:         "polygon",
+		Symbol:        "usdc",
+		Decimals:      _usdc_decimals,
+		ChainID:       big.NewInt(137),
+		TokenContract: _usdc_polygon_contract,
+	}, USDC_POLYGON, privateKey, rpc)
+}
 
-	return &Ethereum{
-		name:       "arbitrum",
-		chain:      "arbitrum",
-		symbol:     "arb",
-		signer:     s,
-		tokenType:  ARBITRUM,
-		rpc:        rpc,
-		client:     client,
-		privateKey: prKey,
-		publicKey:  publicKeyECDSA,
-	}, nil
+// NewEVM create currency object for any evm chain supported by irys node
+//
+// Example:
+//
+//	base, err := currency.NewEVM(currency.EVMConfig{
+//		Name:    "base-eth",
+//		Chain:   "base",
+//		Symbol:  "eth",
+//		ChainID: big.NewInt(8453),
+//	}, "ExamplePrivateKey", "ExampleRpc")
+func NewEVM(config EVMConfig, privateKey, rpc string) (Currency, error) {
+	if len(config.Name) == 0 {
+		return nil, errors.ErrCurrencyIsInvalid
+	}
+	return newEVM(config, EVM, privateKey, rpc)
 }
 
-// NewAvalanche create avalanche object currency
-func NewAvalanche(privateKey, rpc string) (Currency, error) {
+func newEVM(config EVMConfig, tokenType CurrencyType, privateKey, rpc string) (Currency, error) {
 	if len(privateKey) == 0 {
 		return nil, errors.ErrPrivateKeyIsEmpty
 	}
 
-	s, err := signer.NewEthereumSigner(_0x_prefix + privateKey)
+	prKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, _0x_prefix))
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := ethclient.Dial(rpc)
-	if err != nil {
-		return nil, err
-	}
+	return newEVMFromKey(config, tokenType, prKey, rpc)
+}
 
-	prKey, err := crypto.HexToECDSA(privateKey)
+func newEVMFromKey(config EVMConfig, tokenType CurrencyType, prKey *ecdsa.PrivateKey, rpc string) (Currency, error) {
+	local := &localTxSigner{key: prKey}
+	e, err := newEVMWithSigner(config, tokenType, &signer.EthereumSigner{PrivateKey: prKey}, local, rpc)
 	if err != nil {
 		return nil, err
 	}
-
-	pbKey := prKey.Public()
-	publicKeyECDSA, ok := pbKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, errors.ErrAssertionPublicKey
-	}
-
-	return &Ethereum{
-		name:       "avalanche",
-		chain:      "avalanche",
-		symbol:     "avax",
-		signer:     s,
-		tokenType:  AVALANCHE,
-		rpc:        rpc,
-		client:     client,
-		privateKey: prKey,
-		publicKey:  publicKeyECDSA,
-	}, nil
+	e.privateKey = prKey
+	return e, nil
 }
 
-// NewFantom create fantom object currency
-func NewFantom(privateKey, rpc string) (Currency, error) {
-	if len(privateKey) == 0 {
-		return nil, errors.ErrPrivateKeyIsEmpty
+// NewEVMWithSigner create evm currency object which sign data items and funding transactions with external signer
+// (e.g. signer.ClefSigner), so private key never enter process.
+//
+// Example:
+//
+//	remote, err := signer.NewClefSigner("http://localhost:8550", common.HexToAddress("ExampleAddress"), nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	matic, err := currency.NewEVMWithSigner(currency.EVMConfig{
+//		Name:    "matic",
+//		Chain:   "polygon",
+//		Symbol:  "matic",
+//		ChainID: big.NewInt(137),
+//	}, remote, "ExampleRpc")
+func NewEVMWithSigner(config EVMConfig, s EVMSigner, rpc string) (Currency, error) {
+	if len(config.Name) == 0 {
+		return nil, errors.ErrCurrencyIsInvalid
 	}
-
-	s, err := signer.NewEthereumSigner(_0x_prefix + privateKey)
-	if err != nil {
-		return nil, err
+	if s == nil {
+		return nil, errors.ErrSignerIsEmpty
 	}
+	return newEVMWithSigner(config, EVM, s, s, rpc)
+}
 
-	client, err := ethclient.Dial(rpc)
-	if err != nil {
-		return nil, err
+func newEVMWithSigner(config EVMConfig, tokenType CurrencyType, dataSigner signer.Signer, txSigner TxSigner, rpc string) (*Ethereum, error) {
+	if len(config.TokenContract) != 0 && !common.IsHexAddress(config.TokenContract) {
+		return nil, errors.ErrInvalidAddress
 	}
 
-	prKey, err := crypto.HexToECDSA(privateKey)
-	if err != nil {
-		return nil, err
+	if config.Decimals == 0 {
+		config.Decimals = _evm_decimals
 	}
 
-	pbKey := prKey.Public()
-	publicKeyECDSA, ok := pbKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, errors.ErrAssertionPublicKey
+	e := &Ethereum{
+		name:      config.Name,
+		chain:     config.Chain,
+		symbol:    config.Symbol,
+		signer:    dataSigner,
+		txSigner:  txSigner,
+		address:   txSigner.Address(),
+		rpc:       rpc,
+		tokenType: tokenType,
+		decimals:  config.Decimals,
+		contract:  config.TokenContract,
+		chainID:   config.ChainID,
 	}
+	e.nonces = NewNonceManager(e)
 
-	return &Ethereum{
-		name:       "fantom",
-		chain:      "fantom",
-		symbol:     "ftm",
-		signer:     s,
-		tokenType:  FANTOM,
-		rpc:        rpc,
-		client:     client,
-		privateKey: prKey,
-		publicKey:  publicKeyECDSA,
-	}, nil
+	return e, nil
 }
 
 func (e *Ethereum) GetChain() string {
@@ -273,6 +250,10 @@ func (e *Ethereum) GetName() string {
 	return e.name
 }
 
+func (e *Ethereum) GetAddress() string {
+	return e.address.Hex()
+}
+
 func (e *Ethereum) GetSinger() signer.Signer {
 	return e.signer
 }
@@ -281,18 +262,143 @@ func (e *Ethereum) GetRPCAddr() string {
 	return e.rpc
 }
 
+// GetRPCClient return rpc client of currency, it's dialled if not connected yet and nil for sign only currency
 func (e *Ethereum) GetRPCClient() *ethclient.Client {
-	return e.client
+	client, err := e.rpcClient(context.Background())
+	if err != nil {
+		return nil
+	}
+	return client
 }
 
+// GetPrivateKey return private key of wallet, it's nil when currency use external signer
 func (e *Ethereum) GetPrivateKey() *ecdsa.PrivateKey {
 	return e.privateKey
 }
 
+// GetPublicKey return public key of wallet, it's nil when currency use external signer
 func (e *Ethereum) GetPublicKey() *ecdsa.PublicKey {
-	return e.publicKey
+	if e.privateKey == nil {
+		return nil
+	}
+	return &e.privateKey.PublicKey
 }
 
 func (e *Ethereum) GetType() CurrencyType {
 	return e.tokenType
 }
+
+func (e *Ethereum) Decimals() int {
+	return e.decimals
+}
+
+func (e *Ethereum) GetTokenContract() string {
+	return e.contract
+}
+
+func (e *Ethereum) GetNonceManager() *NonceManager {
+	return e.nonces
+}
+
+// GetChainID return chain id of currency, it's fetched from rpc once when not configured
+func (e *Ethereum) GetChainID(ctx context.Context) (*big.Int, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.chainID != nil {
+		return e.chainID, nil
+	}
+
+	var chainID *big.Int
+	if err := e.withClient(ctx, func(client *ethclient.Client) (err error) {
+		chainID, err = client.ChainID(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	e.chainID = chainID
+	return chainID, nil
+}
+
+// Close zero private key of wallet and close rpc client, currency can't sign after close
+func (e *Ethereum) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	zeroKey(e.privateKey)
+
+	e.clientMu.Lock()
+	defer e.clientMu.Unlock()
+	if e.client != nil {
+		e.client.Close()
+		e.client = nil
+	}
+
+	return nil
+}
+
+// PendingNonceAt implement NonceSource with rpc client of currency
+func (e *Ethereum) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = e.withClient(ctx, func(client *ethclient.Client) error {
+		nonce, err = client.PendingNonceAt(ctx, account)
+		return err
+	})
+	return nonce, err
+}
+
+// rpcClient return connected rpc client, it's dialled on first use so currency can be created without
+// reachable rpc, sign only currencies (empty rpc) return ErrSignOnlyCurrency
+func (e *Ethereum) rpcClient(ctx context.Context) (*ethclient.Client, error) {
+	if len(e.rpc) == 0 {
+		return nil, errors.ErrSignOnlyCurrency
+	}
+
+	e.clientMu.Lock()
+	defer e.clientMu.Unlock()
+
+	if e.client != nil {
+		return e.client, nil
+	}
+
+	client, err := ethclient.DialContext(ctx, e.rpc)
+	if err != nil {
+		return nil, err
+	}
+	e.client = client
+
+	return client, nil
+}
+
+// withClient call fn with rpc client, client is dropped on connection error so next call reconnect
+func (e *Ethereum) withClient(ctx context.Context, fn func(client *ethclient.Client) error) error {
+	client, err := e.rpcClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = fn(client)
+	if isConnectionError(err) {
+		e.clientMu.Lock()
+		if e.client == client {
+			e.client.Close()
+			e.client = nil
+		}
+		e.clientMu.Unlock()
+	}
+
+	return err
+}
+
+// isConnectionError check error is caused by broken connection to rpc rather than rpc response
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	return stderrors.As(err, &netErr) ||
+		stderrors.Is(err, io.EOF) ||
+		stderrors.Is(err, io.ErrUnexpectedEOF) ||
+		stderrors.Is(err, rpc.ErrClientQuit)
+}
@@ -0,0 +1,247 @@
+package currency
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"golang.org/x/crypto/sha3"
+)
+
+// GetWalletBalance return native coin balance of wallet, for erc-20 currencies token balance is returned
+func (e *Ethereum) GetWalletBalance(ctx context.Context) (*big.Int, error) {
+	fromAddress := e.address
+
+	var balance *big.Int
+	err := e.withClient(ctx, func(client *ethclient.Client) (err error) {
+		if len(e.contract) == 0 {
+			balance, err = client.BalanceAt(ctx, fromAddress, nil)
+			return err
+		}
+
+		token := common.HexToAddress(e.contract)
+		result, err := client.CallContract(ctx, ethereum.CallMsg{
+			To:   &token,
+			Data: erc20BalanceOfData(fromAddress),
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		balance = new(big.Int).SetBytes(result)
+		return nil
+	})
+
+	return balance, err
+}
+
+// SendFunds send amount to address, for native coin currencies amount is sent as
+// transaction value and for erc-20 currencies amount is sent with token transfer call.
+func (e *Ethereum) SendFunds(ctx context.Context, to string, amount *big.Int) (string, error) {
+	if !common.IsHexAddress(to) {
+		return "", fmt.Errorf("%s is not evm address", to)
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return "", errors.ErrAmountNotPositive
+	}
+
+	fromAddress := e.address
+	nodeAddress := common.HexToAddress(to)
+
+	toAddress := nodeAddress
+	value := amount
+	var data []byte
+
+	if len(e.contract) != 0 {
+		toAddress = common.HexToAddress(e.contract)
+		value = big.NewInt(0)
+		data = erc20TransferData(nodeAddress, amount)
+	}
+
+	chainID, err := e.GetChainID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var gasPrice *big.Int
+	var gasLimit uint64
+	if err := e.withClient(ctx, func(client *ethclient.Client) (err error) {
+		gasPrice, err = client.SuggestGasPrice(ctx)
+		if err != nil {
+			return err
+		}
+
+		gasLimit, err = client.EstimateGas(ctx, ethereum.CallMsg{
+			From:  fromAddress,
+			To:    &toAddress,
+			Value: value,
+			Data:  data,
+		})
+		return err
+	}); err != nil {
+		return "", err
+	}
+
+	nonce, err := e.nonces.Next(ctx, fromAddress)
+	if err != nil {
+		return "", err
+	}
+
+	tx := types.NewTransaction(
+		nonce,
+		toAddress,
+		value,
+		gasLimit,
+		gasPrice,
+		data,
+	)
+
+	signedTx, err := e.txSigner.SignTx(ctx, tx, chainID)
+	if err != nil {
+		e.nonces.Reset(fromAddress)
+		return "", err
+	}
+
+	if err = e.sendTransaction(ctx, signedTx); err != nil {
+		// nonce may be consumed by other wallet user or never used, so resync it
+		e.nonces.Reset(fromAddress)
+		return "", err
+	}
+
+	e.nonces.Track(fromAddress, signedTx)
+
+	return signedTx.Hash().Hex(), nil
+}
+
+// WaitForConfirmation wait until transaction is mined, reverted transaction return error.
+// mined transaction is untracked from pending transactions of SpeedUpPending
+func (e *Ethereum) WaitForConfirmation(ctx context.Context, txId string) error {
+	hash := common.HexToHash(txId)
+
+	return pollConfirmation(ctx, func() (bool, error) {
+		receipt, err := e.transactionReceipt(ctx, hash)
+		if stderrors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		// reverted transaction consume nonce too
+		e.nonces.Confirm(e.address, hash)
+
+		if receipt.Status == types.ReceiptStatusFailed {
+			return false, fmt.Errorf("transaction %s reverted", txId)
+		}
+		return true, nil
+	})
+}
+
+// SpeedUpPending rebroadcast pending transactions which are not mined yet with same nonce and
+// higher gas price, mined transactions are untracked.
+func (e *Ethereum) SpeedUpPending(ctx context.Context) ([]string, error) {
+	fromAddress := e.address
+
+	pending := e.nonces.Pending(fromAddress)
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	chainID, err := e.GetChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var suggested *big.Int
+	if err := e.withClient(ctx, func(client *ethclient.Client) (err error) {
+		suggested, err = client.SuggestGasPrice(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(pending))
+	for _, tx := range pending {
+		_, err := e.transactionReceipt(ctx, tx.Hash())
+		if err == nil {
+			e.nonces.Untrack(fromAddress, tx.Nonce())
+			continue
+		}
+		if !stderrors.Is(err, ethereum.NotFound) {
+			return hashes, err
+		}
+
+		gasPrice := bumpGasPrice(tx.GasPrice())
+		if gasPrice.Cmp(suggested) < 0 {
+			gasPrice = suggested
+		}
+
+		replacement := types.NewTransaction(
+			tx.Nonce(),
+			*tx.To(),
+			tx.Value(),
+			tx.Gas(),
+			gasPrice,
+			tx.Data(),
+		)
+
+		signedTx, err := e.txSigner.SignTx(ctx, replacement, chainID)
+		if err != nil {
+			return hashes, err
+		}
+
+		if err := e.sendTransaction(ctx, signedTx); err != nil {
+			return hashes, err
+		}
+
+		e.nonces.Track(fromAddress, signedTx)
+		hashes = append(hashes, signedTx.Hash().Hex())
+	}
+
+	return hashes, nil
+}
+
+func (e *Ethereum) sendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return e.withClient(ctx, func(client *ethclient.Client) error {
+		return client.SendTransaction(ctx, tx)
+	})
+}
+
+func (e *Ethereum) transactionReceipt(ctx context.Context, hash common.Hash) (receipt *types.Receipt, err error) {
+	err = e.withClient(ctx, func(client *ethclient.Client) error {
+		receipt, err = client.TransactionReceipt(ctx, hash)
+		return err
+	})
+	return receipt, err
+}
+
+// bumpGasPrice increase gas price 12.5%, nodes reject replacement transactions with less than 10% bump
+func bumpGasPrice(gasPrice *big.Int) *big.Int {
+	bumped := new(big.Int).Div(gasPrice, big.NewInt(8))
+	bumped.Add(bumped, gasPrice)
+	return bumped.Add(bumped, big.NewInt(1))
+}
+
+// erc20TransferData build calldata of transfer(address,uint256)
+func erc20TransferData(to common.Address, amount *big.Int) []byte {
+	data := erc20MethodID("transfer(address,uint256)")
+	data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+	return append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+}
+
+// erc20BalanceOfData build calldata of balanceOf(address)
+func erc20BalanceOfData(owner common.Address) []byte {
+	return append(erc20MethodID("balanceOf(address)"), common.LeftPadBytes(owner.Bytes(), 32)...)
+}
+
+func erc20MethodID(signature string) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(signature))
+	return hash.Sum(nil)[:4]
+}
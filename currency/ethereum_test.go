@@ -0,0 +1,235 @@
+package currency
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+const _test_evm_private_key = "8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f"
+
+func TestEthereum_SignOnly(t *testing.T) {
+	c, err := NewMatic(_test_evm_private_key, "")
+	require.NoError(t, err)
+
+	signature, err := c.GetSinger().Sign([]byte("to be signed"))
+	require.NoError(t, err)
+	require.NoError(t, c.GetSinger().Verify([]byte("to be signed"), signature))
+
+	funder := c.(Funder)
+	_, err = funder.GetWalletBalance(context.Background())
+	require.ErrorIs(t, err, errors.ErrSignOnlyCurrency)
+
+	_, err = funder.SendFunds(context.Background(), "0x853758425e953739F5438fd6fd0Efe04A477b039", big.NewInt(1))
+	require.ErrorIs(t, err, errors.ErrSignOnlyCurrency)
+}
+
+func TestEthereum_LazyDial(t *testing.T) {
+	// rpc is not reachable, currency is created because client is dialled on first use
+	c, err := NewMatic(_test_evm_private_key, "http://127.0.0.1:1")
+	require.NoError(t, err)
+	require.Nil(t, c.(*Ethereum).client)
+
+	_, err = c.(Funder).GetWalletBalance(context.Background())
+	require.Error(t, err)
+	require.True(t, isConnectionError(err))
+	require.Nil(t, c.(*Ethereum).client)
+}
+
+// fakeEVMRPC is json-rpc server of evm node which keep sent transactions
+type fakeEVMRPC struct {
+	mu         sync.Mutex
+	nonce      uint64
+	nonceCalls int
+	sendErr    string
+	sent       []*types.Transaction
+	mined      map[common.Hash]bool
+}
+
+func newFakeEVMRPC(t *testing.T, nonce uint64) (*fakeEVMRPC, string) {
+	f := &fakeEVMRPC{nonce: nonce, mined: make(map[common.Hash]bool)}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return f, srv.URL
+}
+
+func (f *fakeEVMRPC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var (
+		result any
+		rpcErr string
+	)
+	switch req.Method {
+	case "eth_chainId":
+		result = "0x89"
+	case "eth_gasPrice":
+		result = "0x3b9aca00"
+	case "eth_estimateGas":
+		result = "0x5208"
+	case "eth_getTransactionCount":
+		f.nonceCalls++
+		result = hexutil.Uint64(f.nonce)
+	case "eth_sendRawTransaction":
+		if len(f.sendErr) != 0 {
+			rpcErr = f.sendErr
+			break
+		}
+		var raw hexutil.Bytes
+		_ = json.Unmarshal(req.Params[0], &raw)
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			rpcErr = err.Error()
+			break
+		}
+		f.sent = append(f.sent, tx)
+		result = tx.Hash()
+	case "eth_getTransactionReceipt":
+		var hash common.Hash
+		_ = json.Unmarshal(req.Params[0], &hash)
+		if !f.mined[hash] {
+			result = nil
+			break
+		}
+		result = map[string]any{
+			"transactionHash":   hash,
+			"status":            "0x1",
+			"cumulativeGasUsed": "0x5208",
+			"gasUsed":           "0x5208",
+			"logsBloom":         types.Bloom{},
+			"logs":              []any{},
+		}
+	default:
+		rpcErr = "method not found"
+	}
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	if len(rpcErr) != 0 {
+		resp["error"] = map[string]any{"code": -32000, "message": rpcErr}
+	} else {
+		resp["result"] = result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func TestErc20TransferData(t *testing.T) {
+	to := common.HexToAddress("0x853758425e953739F5438fd6fd0Efe04A477b039")
+	data := erc20TransferData(to, big.NewInt(1000))
+
+	require.Len(t, data, 4+32+32)
+	require.Equal(t, "a9059cbb", hex.EncodeToString(data[:4]))
+	require.Equal(t, "000000000000000000000000853758425e953739f5438fd6fd0efe04a477b039", hex.EncodeToString(data[4:36]))
+	require.Equal(t, "00000000000000000000000000000000000000000000000000000000000003e8", hex.EncodeToString(data[36:]))
+}
+
+func TestEthereum_SendFunds(t *testing.T) {
+	to := common.HexToAddress("0x853758425e953739F5438fd6fd0Efe04A477b039")
+
+	t.Run("native", func(t *testing.T) {
+		rpc, url := newFakeEVMRPC(t, 5)
+		c, err := NewMatic(_test_evm_private_key, url)
+		require.NoError(t, err)
+
+		hash, err := c.(Funder).SendFunds(context.Background(), to.Hex(), big.NewInt(1000))
+		require.NoError(t, err)
+
+		require.Len(t, rpc.sent, 1)
+		tx := rpc.sent[0]
+		require.Equal(t, hash, tx.Hash().Hex())
+		require.Equal(t, to, *tx.To())
+		require.Equal(t, big.NewInt(1000), tx.Value())
+		require.Empty(t, tx.Data())
+		require.Equal(t, uint64(5), tx.Nonce())
+		require.Equal(t, big.NewInt(137), tx.ChainId())
+	})
+
+	t.Run("non positive amount", func(t *testing.T) {
+		rpc, url := newFakeEVMRPC(t, 0)
+		c, err := NewMatic(_test_evm_private_key, url)
+		require.NoError(t, err)
+
+		for _, amount := range []*big.Int{big.NewInt(-1), big.NewInt(0), nil} {
+			_, err = c.(Funder).SendFunds(context.Background(), to.Hex(), amount)
+			require.ErrorIs(t, err, errors.ErrAmountNotPositive)
+		}
+		require.Empty(t, rpc.sent)
+	})
+
+	t.Run("erc20", func(t *testing.T) {
+		rpc, url := newFakeEVMRPC(t, 0)
+		c, err := NewUSDCPolygon(_test_evm_private_key, url)
+		require.NoError(t, err)
+
+		_, err = c.(Funder).SendFunds(context.Background(), to.Hex(), big.NewInt(1000))
+		require.NoError(t, err)
+
+		require.Len(t, rpc.sent, 1)
+		tx := rpc.sent[0]
+		require.Equal(t, common.HexToAddress(_usdc_polygon_contract), *tx.To())
+		require.Zero(t, tx.Value().Sign())
+		require.Equal(t, erc20TransferData(to, big.NewInt(1000)), tx.Data())
+	})
+}
+
+func TestEthereum_SendFundsNonce(t *testing.T) {
+	to := "0x853758425e953739F5438fd6fd0Efe04A477b039"
+	rpc, url := newFakeEVMRPC(t, 5)
+	c, err := NewMatic(_test_evm_private_key, url)
+	require.NoError(t, err)
+	e := c.(*Ethereum)
+	ctx := context.Background()
+
+	// nonces are sequential without resync
+	for i := 0; i < 3; i++ {
+		_, err := e.SendFunds(ctx, to, big.NewInt(1))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, rpc.nonceCalls)
+	for i, tx := range rpc.sent {
+		require.Equal(t, uint64(5+i), tx.Nonce())
+	}
+	require.Len(t, e.nonces.Pending(e.address), 3)
+
+	// failed send reset nonce, so next send resync it from rpc
+	rpc.sendErr = "nonce too low"
+	_, err = e.SendFunds(ctx, to, big.NewInt(1))
+	require.Error(t, err)
+
+	rpc.sendErr = ""
+	rpc.nonce = 8
+	_, err = e.SendFunds(ctx, to, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, 2, rpc.nonceCalls)
+	require.Equal(t, uint64(8), rpc.sent[3].Nonce())
+
+	// confirmation of transaction untrack it and lower nonces
+	rpc.mined[rpc.sent[2].Hash()] = true
+	require.NoError(t, e.WaitForConfirmation(ctx, rpc.sent[2].Hash().Hex()))
+
+	pending := e.nonces.Pending(e.address)
+	require.Len(t, pending, 1)
+	require.Equal(t, rpc.sent[3].Hash(), pending[0].Hash())
+}
@@ -0,0 +1,26 @@
+package currency
+
+import (
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+)
+
+// Info is metadata of currency used by irys nodes
+type Info struct {
+	Name          string
+	Symbol        string
+	Decimals      int
+	SignatureType signer.SignatureType
+}
+
+// GetInfo return metadata of registered currency by irys name
+func GetInfo(name string) (Info, error) {
+	_registryMu.RLock()
+	defer _registryMu.RUnlock()
+
+	r, ok := _registry[name]
+	if !ok {
+		return Info{}, errors.ErrCurrencyIsInvalid
+	}
+	return r.info, nil
+}
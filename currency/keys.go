@@ -0,0 +1,185 @@
+package currency
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// DefaultDerivationPath is bip-44 path of ethereum accounts, account index is appended to it
+	DefaultDerivationPath = "m/44'/60'/0'/0"
+
+	_bip39_iterations  = 2048
+	_bip39_seed_length = 64
+	_bip32_master_key  = "Bitcoin seed"
+	_bip32_hardened    = 0x80000000
+)
+
+// NewEVMFromKeystore create evm currency object by go-ethereum json keystore file and passphrase
+func NewEVMFromKeystore(config EVMConfig, keystorePath, passphrase, rpc string) (Currency, error) {
+	keyJSON, err := os.ReadFile(keystorePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEVMFromKeystoreJSON(config, keyJSON, passphrase, rpc)
+}
+
+// NewEVMFromKeystoreJSON create evm currency object by go-ethereum json keystore payload and passphrase
+func NewEVMFromKeystoreJSON(config EVMConfig, keyJSON []byte, passphrase, rpc string) (Currency, error) {
+	if len(config.Name) == 0 {
+		return nil, errors.ErrCurrencyIsInvalid
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return newEVMFromKey(config, EVM, key.PrivateKey, rpc)
+}
+
+// NewEVMFromMnemonic create evm currency object by bip-39 mnemonic, derivation path (e.g. DefaultDerivationPath)
+// and account index, key is derived at path/index same as common ethereum wallets.
+//
+// Example:
+//
+//	matic, err := currency.NewEVMFromMnemonic(currency.EVMConfig{
+//		Name:    "matic",
+//		Chain:   "polygon",
+//		Symbol:  "matic",
+//		ChainID: big.NewInt(137),
+//	}, "ExampleMnemonic", "", currency.DefaultDerivationPath, 0, "ExampleRpc")
+func NewEVMFromMnemonic(config EVMConfig, mnemonic, passphrase, path string, index uint32, rpc string) (Currency, error) {
+	if len(config.Name) == 0 {
+		return nil, errors.ErrCurrencyIsInvalid
+	}
+
+	key, err := DeriveEVMKey(mnemonic, passphrase, path, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return newEVMFromKey(config, EVM, key, rpc)
+}
+
+// DeriveEVMKey derive secp256k1 private key of bip-39 mnemonic at bip-32 path/index, mnemonic must be
+// english words with valid checksum
+func DeriveEVMKey(mnemonic, passphrase, path string, index uint32) (*ecdsa.PrivateKey, error) {
+	words, err := mnemonicWords(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(path) == 0 {
+		path = DefaultDerivationPath
+	}
+
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	derivationPath = append(derivationPath, index)
+
+	password := []byte(strings.Join(words, " "))
+	defer zeroBytes(password)
+
+	seed := pbkdf2.Key(password, []byte("mnemonic"+norm.NFKD.String(passphrase)),
+		_bip39_iterations, _bip39_seed_length, sha512.New)
+	defer zeroBytes(seed)
+
+	return deriveKey(seed, derivationPath)
+}
+
+// deriveKey derive bip-32 private child key of seed, see https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki
+func deriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	mac := hmac.New(sha512.New, []byte(_bip32_master_key))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	defer zeroBytes(sum)
+
+	key, chainCode := new(big.Int).SetBytes(sum[:32]), append([]byte{}, sum[32:]...)
+	defer zeroInt(key)
+	defer zeroBytes(chainCode)
+
+	// buffers of key material are reused for each child and zeroed at return
+	keyBytes := make([]byte, 32)
+	defer zeroBytes(keyBytes)
+	data := make([]byte, 0, 1+32+4)
+	defer zeroBytes(data[:cap(data)])
+	tweak := new(big.Int)
+	defer zeroInt(tweak)
+
+	n := crypto.S256().Params().N
+
+	for _, child := range path {
+		key.FillBytes(keyBytes)
+		if child >= _bip32_hardened {
+			data = append(append(data[:0], 0), keyBytes...)
+		} else {
+			priv, err := crypto.ToECDSA(keyBytes)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data[:0], crypto.CompressPubkey(&priv.PublicKey)...)
+			zeroKey(priv)
+		}
+		data = append(data, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(data[len(data)-4:], child)
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		childSum := mac.Sum(sum[:0])
+
+		tweak.SetBytes(childSum[:32])
+		if tweak.Cmp(n) >= 0 {
+			return nil, errors.ErrInvalidDerivedKey
+		}
+
+		key.Add(key, tweak).Mod(key, n)
+		if key.Sign() == 0 {
+			return nil, errors.ErrInvalidDerivedKey
+		}
+		copy(chainCode, childSum[32:])
+	}
+
+	return crypto.ToECDSA(key.FillBytes(keyBytes))
+}
+
+// zeroKey overwrite private scalar of key, key is unusable after zero
+func zeroKey(key *ecdsa.PrivateKey) {
+	if key == nil {
+		return
+	}
+	zeroInt(key.D)
+}
+
+// zeroInt overwrite words of n and set it to zero
+func zeroInt(n *big.Int) {
+	if n == nil {
+		return
+	}
+	b := n.Bits()
+	for i := range b {
+		b[i] = 0
+	}
+	n.SetInt64(0)
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,97 @@
+package currency
+
+import (
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const _test_mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestDeriveEVMKey(t *testing.T) {
+	tests := []struct {
+		index uint32
+		want  string
+	}{
+		{0, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"},
+		{1, "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"},
+	}
+
+	for _, tt := range tests {
+		key, err := DeriveEVMKey(_test_mnemonic, "", DefaultDerivationPath, tt.index)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	}
+
+	_, err := DeriveEVMKey("abandon about", "", DefaultDerivationPath, 0)
+	require.ErrorIs(t, err, errors.ErrInvalidMnemonic)
+}
+
+func TestMnemonicWords(t *testing.T) {
+	tests := []struct {
+		mnemonic string
+		err      error
+	}{
+		{_test_mnemonic, nil},
+		{strings.Repeat("abandon ", 23) + "art", nil},
+		{strings.Repeat("zoo ", 11) + "wrong", nil},
+		{strings.Repeat("abandon ", 12), errors.ErrMnemonicChecksum},
+		{strings.Repeat("zoo ", 12), errors.ErrMnemonicChecksum},
+		{strings.Repeat("foo bar baz qux ", 3), errors.ErrMnemonicUnknownWord},
+		{strings.Replace(_test_mnemonic, "about", "abuot", 1), errors.ErrMnemonicUnknownWord},
+		{"abandon about", errors.ErrInvalidMnemonic},
+	}
+
+	for _, tt := range tests {
+		_, err := mnemonicWords(tt.mnemonic)
+		if tt.err == nil {
+			require.NoError(t, err, tt.mnemonic)
+			continue
+		}
+		require.ErrorIs(t, err, tt.err, tt.mnemonic)
+
+		_, err = DeriveEVMKey(tt.mnemonic, "", DefaultDerivationPath, 0)
+		require.ErrorIs(t, err, tt.err, tt.mnemonic)
+	}
+}
+
+func TestDeriveEVMKey_NFKD(t *testing.T) {
+	// passphrase is NFKD normalized, so composed and decomposed forms derive same key
+	composed, err := DeriveEVMKey(_test_mnemonic, "caf\u00e9", DefaultDerivationPath, 0)
+	require.NoError(t, err)
+	decomposed, err := DeriveEVMKey(_test_mnemonic, "cafe\u0301", DefaultDerivationPath, 0)
+	require.NoError(t, err)
+	require.Equal(t, crypto.FromECDSA(composed), crypto.FromECDSA(decomposed))
+}
+
+func TestNewEVMFromKeystoreJSON(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var id [16]byte
+	_, err = rand.Read(id[:])
+	require.NoError(t, err)
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(key.PublicKey),
+		PrivateKey: key,
+	}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	_, err = NewEVMFromKeystoreJSON(EVMConfig{Name: "matic"}, keyJSON, "wrong", "http://127.0.0.1:8545")
+	require.Error(t, err)
+
+	c, err := NewEVMFromKeystoreJSON(EVMConfig{Name: "matic"}, keyJSON, "secret", "http://127.0.0.1:8545")
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey).Hex(), c.GetAddress())
+
+	require.NoError(t, c.(io.Closer).Close())
+	require.Zero(t, c.(*Ethereum).privateKey.D.Sign())
+}
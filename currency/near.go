@@ -0,0 +1,262 @@
+package currency
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+	"github.com/Ja7ad/irys/utils/base58"
+)
+
+const (
+	_near_name   = "near"
+	_near_chain  = "near"
+	_near_symbol = "near"
+	// 1 near is 10^24 yoctonear
+	_near_decimals = 24
+
+	_near_ed25519_key_type = 0
+	_near_transfer_action  = 3
+)
+
+type Near struct {
+	chain     string
+	symbol    string
+	name      string
+	rpc       string
+	accountId string
+	tokenType CurrencyType
+	signer    *signer.NearSigner
+}
+
+// NewNear create near currency object by account id, private key ("ed25519:<base58>") and json-rpc address
+func NewNear(accountId, privateKey, rpc string) (Currency, error) {
+	if len(privateKey) == 0 {
+		return nil, errors.ErrPrivateKeyIsEmpty
+	}
+
+	s, err := signer.NewNearSigner(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accountId) == 0 {
+		accountId = s.Address()
+	}
+
+	return &Near{
+		chain:     _near_chain,
+		symbol:    _near_symbol,
+		name:      _near_name,
+		rpc:       rpc,
+		accountId: accountId,
+		tokenType: NEAR,
+		signer:    s,
+	}, nil
+}
+
+func (n *Near) GetChain() string {
+	return n.chain
+}
+
+func (n *Near) GetSymbol() string {
+	return n.symbol
+}
+
+func (n *Near) GetName() string {
+	return n.name
+}
+
+func (n *Near) Decimals() int {
+	return _near_decimals
+}
+
+// GetAddress return near account id which send funds, it's hex public key of signer for implicit account
+func (n *Near) GetAddress() string {
+	return n.accountId
+}
+
+// GetAccountId return near account id used for send transactions
+func (n *Near) GetAccountId() string {
+	return n.accountId
+}
+
+func (n *Near) GetSinger() signer.Signer {
+	return n.signer
+}
+
+func (n *Near) GetRPCAddr() string {
+	return n.rpc
+}
+
+func (n *Near) GetType() CurrencyType {
+	return n.tokenType
+}
+
+// SendFunds transfer amount yoctonear to account id with transfer action and return transaction hash
+func (n *Near) SendFunds(ctx context.Context, to string, amount *big.Int) (string, error) {
+	if len(n.rpc) == 0 {
+		return "", errors.ErrSignOnlyCurrency
+	}
+
+	if len(to) == 0 {
+		return "", errors.ErrInvalidAddress
+	}
+
+	if amount == nil || amount.Sign() <= 0 {
+		return "", errors.ErrAmountNotPositive
+	}
+	if amount.BitLen() > 128 {
+		return "", errors.ErrInvalidAmount
+	}
+
+	var accessKey struct {
+		Nonce     uint64 `json:"nonce"`
+		BlockHash string `json:"block_hash"`
+	}
+	if err := callJSONRPC(ctx, n.rpc, "query", map[string]string{
+		"request_type": "view_access_key",
+		"finality":     "final",
+		"account_id":   n.accountId,
+		"public_key":   n.signer.PublicKey(),
+	}, &accessKey); err != nil {
+		return "", err
+	}
+
+	blockHash, err := base58.Decode(accessKey.BlockHash)
+	if err != nil {
+		return "", err
+	}
+
+	tx := nearTransferTransaction(n.accountId, n.signer.Owner, accessKey.Nonce+1, to, blockHash, amount)
+
+	hash := sha256.Sum256(tx)
+	signature := ed25519.Sign(n.signer.PrivateKey, hash[:])
+
+	signedTx := append(tx, _near_ed25519_key_type)
+	signedTx = append(signedTx, signature...)
+
+	var result struct {
+		Status      map[string]json.RawMessage `json:"status"`
+		Transaction struct {
+			Hash string `json:"hash"`
+		} `json:"transaction"`
+	}
+	if err := callJSONRPC(ctx, n.rpc, "broadcast_tx_commit", []string{
+		base64.StdEncoding.EncodeToString(signedTx),
+	}, &result); err != nil {
+		return "", err
+	}
+
+	if failure, ok := result.Status["Failure"]; ok {
+		return "", fmt.Errorf("near transaction %s failed: %s", result.Transaction.Hash, string(failure))
+	}
+
+	return result.Transaction.Hash, nil
+}
+
+// nearTransferTransaction build borsh serialized transaction with one transfer action
+func nearTransferTransaction(signerId string, publicKey []byte, nonce uint64, receiverId string, blockHash []byte, amount *big.Int) []byte {
+	tx := borshString(signerId)
+	tx = append(tx, _near_ed25519_key_type)
+	tx = append(tx, publicKey...)
+	tx = append(tx, borshU64(nonce)...)
+	tx = append(tx, borshString(receiverId)...)
+	tx = append(tx, blockHash...)
+
+	tx = append(tx, borshU32(1)...) // actions
+	tx = append(tx, _near_transfer_action)
+	tx = append(tx, borshU128(amount)...)
+
+	return tx
+}
+
+func borshU32(n uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, n)
+	return b
+}
+
+func borshU64(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, n)
+	return b
+}
+
+func borshU128(n *big.Int) []byte {
+	b := make([]byte, 16)
+	n.FillBytes(b)
+	// big endian to little endian
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
+
+func borshString(s string) []byte {
+	return append(borshU32(uint32(len(s))), s...)
+}
+
+// GetWalletBalance return balance of account in yoctonear
+func (n *Near) GetWalletBalance(ctx context.Context) (*big.Int, error) {
+	if len(n.rpc) == 0 {
+		return nil, errors.ErrSignOnlyCurrency
+	}
+
+	var account struct {
+		Amount string `json:"amount"`
+	}
+	if err := callJSONRPC(ctx, n.rpc, "query", map[string]string{
+		"request_type": "view_account",
+		"finality":     "final",
+		"account_id":   n.accountId,
+	}, &account); err != nil {
+		return nil, err
+	}
+
+	balance, ok := new(big.Int).SetString(account.Amount, 10)
+	if !ok {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	return balance, nil
+}
+
+// WaitForConfirmation wait until transaction is executed, failed transaction return error
+func (n *Near) WaitForConfirmation(ctx context.Context, txId string) error {
+	if len(n.rpc) == 0 {
+		return errors.ErrSignOnlyCurrency
+	}
+
+	return pollConfirmation(ctx, func() (bool, error) {
+		var result struct {
+			Status map[string]json.RawMessage `json:"status"`
+		}
+		err := callJSONRPC(ctx, n.rpc, "tx", []string{txId, n.accountId}, &result)
+
+		// transaction is unknown until it reach node which serve rpc
+		var rpcErr *RPCError
+		if stderrors.As(err, &rpcErr) && strings.Contains(rpcErr.Message+string(rpcErr.Data), "UNKNOWN_TRANSACTION") {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if failure, ok := result.Status["Failure"]; ok {
+			return false, fmt.Errorf("near transaction %s failed: %s", txId, string(failure))
+		}
+		_, ok := result.Status["SuccessValue"]
+
+		return ok, nil
+	})
+}
@@ -0,0 +1,56 @@
+package currency
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+const _test_near_private_key = "ed25519:" + _test_solana_private_key
+
+func TestNearTransferTransaction(t *testing.T) {
+	publicKey := make([]byte, 32)
+	blockHash := make([]byte, 32)
+	amount, _ := new(big.Int).SetString("1000000000000000000000000", 10)
+
+	tx := nearTransferTransaction("alice.near", publicKey, 7, "bob.near", blockHash, amount)
+
+	require.Len(t, tx, 4+10+1+32+8+4+8+32+4+1+16)
+	require.Equal(t, []byte{10, 0, 0, 0}, tx[:4])
+	require.Equal(t, "alice.near", string(tx[4:14]))
+	require.Equal(t, byte(_near_transfer_action), tx[len(tx)-17])
+	require.Equal(t, amount, new(big.Int).SetBytes(reverse(tx[len(tx)-16:])))
+}
+
+func reverse(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+	return out
+}
+
+func TestNear_GetAddress(t *testing.T) {
+	c, err := NewNear("", _test_near_private_key, "")
+	require.NoError(t, err)
+	near := c.(*Near)
+	require.Equal(t, near.signer.Address(), near.GetAddress())
+
+	c, err = NewNear("alice.near", _test_near_private_key, "")
+	require.NoError(t, err)
+	require.Equal(t, "alice.near", c.GetAddress())
+}
+
+func TestNear_SendFundsNonPositive(t *testing.T) {
+	c, err := NewNear("alice.near", _test_near_private_key, "http://127.0.0.1:1")
+	require.NoError(t, err)
+	near := c.(*Near)
+
+	for _, amount := range []*big.Int{nil, big.NewInt(0), big.NewInt(-1)} {
+		_, err := near.SendFunds(context.Background(), "bob.near", amount)
+		require.ErrorIs(t, err, errors.ErrAmountNotPositive)
+	}
+}
@@ -0,0 +1,136 @@
+package currency
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// _max_pending_txs is max tracked transactions per account, lowest nonces are dropped when it's exceeded
+// because they are mined most likely
+const _max_pending_txs = 256
+
+// NonceSource is the part of rpc client used for sync nonce of account
+type NonceSource interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager hand out sequential nonces per address, so concurrent transactions
+// from one wallet don't race to the same nonce.
+type NonceManager struct {
+	mu      sync.Mutex
+	source  NonceSource
+	nonces  map[common.Address]uint64
+	pending map[common.Address]map[uint64]*types.Transaction
+}
+
+// NewNonceManager create nonce manager base on rpc client
+func NewNonceManager(source NonceSource) *NonceManager {
+	return &NonceManager{
+		source:  source,
+		nonces:  make(map[common.Address]uint64),
+		pending: make(map[common.Address]map[uint64]*types.Transaction),
+	}
+}
+
+// Next return next nonce of account, first call of each account (or after Reset) sync nonce from rpc
+func (n *NonceManager) Next(ctx context.Context, account common.Address) (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	nonce, ok := n.nonces[account]
+	if !ok {
+		synced, err := n.source.PendingNonceAt(ctx, account)
+		if err != nil {
+			return 0, err
+		}
+		nonce = synced
+	}
+
+	n.nonces[account] = nonce + 1
+	return nonce, nil
+}
+
+// Reset drop local nonce of account, next call of Next resync nonce from rpc
+func (n *NonceManager) Reset(account common.Address) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.nonces, account)
+}
+
+// Track keep sent transaction of account as pending until Untrack or Confirm, a tracked transaction
+// with same nonce is replaced
+func (n *NonceManager) Track(account common.Address, tx *types.Transaction) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	txs, ok := n.pending[account]
+	if !ok {
+		txs = make(map[uint64]*types.Transaction)
+		n.pending[account] = txs
+	}
+	txs[tx.Nonce()] = tx
+
+	for len(txs) > _max_pending_txs {
+		lowest := tx.Nonce()
+		for nonce := range txs {
+			if nonce < lowest {
+				lowest = nonce
+			}
+		}
+		delete(txs, lowest)
+	}
+}
+
+// Confirm untrack mined transaction of account by hash, transactions with lower nonce are
+// untracked too because they must be mined before it
+func (n *NonceManager) Confirm(account common.Address, hash common.Hash) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	txs := n.pending[account]
+	for nonce, tx := range txs {
+		if tx.Hash() != hash {
+			continue
+		}
+		for other := range txs {
+			if other <= nonce {
+				delete(txs, other)
+			}
+		}
+		break
+	}
+
+	if len(txs) == 0 {
+		delete(n.pending, account)
+	}
+}
+
+// Untrack remove pending transaction of account by nonce
+func (n *NonceManager) Untrack(account common.Address, nonce uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.pending[account], nonce)
+}
+
+// Pending return tracked transactions of account sorted by nonce
+func (n *NonceManager) Pending(account common.Address) []*types.Transaction {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	txs := make([]*types.Transaction, 0, len(n.pending[account]))
+	for _, tx := range n.pending[account] {
+		txs = append(txs, tx)
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Nonce() < txs[j].Nonce()
+	})
+
+	return txs
+}
@@ -0,0 +1,93 @@
+package currency
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNonceSource struct {
+	nonce uint64
+	calls int
+}
+
+func (f *fakeNonceSource) PendingNonceAt(_ context.Context, _ common.Address) (uint64, error) {
+	f.calls++
+	return f.nonce, nil
+}
+
+func TestNonceManager_Next(t *testing.T) {
+	source := &fakeNonceSource{nonce: 7}
+	nm := NewNonceManager(source)
+	account := common.HexToAddress("0x1")
+
+	var wg sync.WaitGroup
+	nonces := make([]uint64, 10)
+	errs := make([]error, 10)
+	for i := range nonces {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			nonces[i], errs[i] = nm.Next(context.Background(), account)
+		}(i)
+	}
+	wg.Wait()
+
+	got := make(map[uint64]bool)
+	for i, nonce := range nonces {
+		require.NoError(t, errs[i])
+		got[nonce] = true
+	}
+	require.Len(t, got, 10)
+	for n := uint64(7); n < 17; n++ {
+		require.True(t, got[n])
+	}
+	require.Equal(t, 1, source.calls)
+
+	source.nonce = 9
+	nm.Reset(account)
+	nonce, err := nm.Next(context.Background(), account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), nonce)
+	require.Equal(t, 2, source.calls)
+}
+
+func TestNonceManager_Confirm(t *testing.T) {
+	nm := NewNonceManager(&fakeNonceSource{})
+	account := common.HexToAddress("0x1")
+
+	txs := make([]*types.Transaction, 4)
+	for i := range txs {
+		txs[i] = types.NewTransaction(uint64(i), account, big.NewInt(1), 21000, big.NewInt(1), nil)
+		nm.Track(account, txs[i])
+	}
+
+	nm.Confirm(account, txs[1].Hash())
+	require.Equal(t, []*types.Transaction{txs[2], txs[3]}, nm.Pending(account))
+
+	// unknown hash (e.g. replaced transaction) doesn't untrack anything
+	nm.Confirm(account, common.HexToHash("0x2"))
+	require.Len(t, nm.Pending(account), 2)
+
+	nm.Confirm(account, txs[3].Hash())
+	require.Empty(t, nm.Pending(account))
+	require.Empty(t, nm.pending)
+}
+
+func TestNonceManager_TrackLimit(t *testing.T) {
+	nm := NewNonceManager(&fakeNonceSource{})
+	account := common.HexToAddress("0x1")
+
+	for i := 0; i < _max_pending_txs+10; i++ {
+		nm.Track(account, types.NewTransaction(uint64(i), account, big.NewInt(1), 21000, big.NewInt(1), nil))
+	}
+
+	pending := nm.Pending(account)
+	require.Len(t, pending, _max_pending_txs)
+	require.Equal(t, uint64(10), pending[0].Nonce())
+}
@@ -0,0 +1,135 @@
+package currency
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+)
+
+// Options is parameters passed to currency factory
+type Options struct {
+	PrivateKey string // PrivateKey of wallet, format is specific to currency
+	RPC        string // RPC is address of chain node used for funding
+	AccountId  string // AccountId is used by account based chains (e.g. near), optional
+}
+
+// Factory create currency object by options
+type Factory func(opts Options) (Currency, error)
+
+type registration struct {
+	info    Info
+	factory Factory
+}
+
+var (
+	_registryMu sync.RWMutex
+	_registry   = make(map[string]registration)
+)
+
+func init() {
+	for _, r := range []struct {
+		info    Info
+		factory Factory
+	}{
+		{Info{Name: "ethereum", Symbol: "eth", Decimals: _evm_decimals, SignatureType: signer.Ethereum}, withKey(NewEthereum)},
+		{Info{Name: "matic", Symbol: "matic", Decimals: _evm_decimals, SignatureType: signer.Ethereum}, withKey(NewMatic)},
+		{Info{Name: "bnb", Symbol: "bnb", Decimals: _evm_decimals, SignatureType: signer.Ethereum}, withKey(NewBNB)},
+		{Info{Name: "arbitrum", Symbol: "arb", Decimals: _evm_decimals, SignatureType: signer.Ethereum}, withKey(NewArbitrum)},
+		{Info{Name: "avalanche", Symbol: "avax", Decimals: _evm_decimals, SignatureType: signer.Ethereum}, withKey(NewAvalanche)},
+		{Info{Name: "fantom", Symbol: "ftm", Decimals: _evm_decimals, SignatureType: signer.Ethereum}, withKey(NewFantom)},
+		{Info{Name: "usdc-eth", Symbol: "usdc", Decimals: _usdc_decimals, SignatureType: signer.Ethereum}, withKey(NewUSDCEthereum)},
+		{Info{Name: "usdc-polygon", Symbol: "usdc", Decimals: _usdc_decimals, SignatureType: signer.Ethereum}, withKey(NewUSDCPolygon)},
+		{Info{Name: _arweave_name, Symbol: _arweave_symbol, Decimals: _arweave_decimals, SignatureType: signer.Arweave}, func(opts Options) (Currency, error) {
+			return NewArweave(opts.PrivateKey)
+		}},
+		{Info{Name: _solana_name, Symbol: _solana_symbol, Decimals: _solana_decimals, SignatureType: signer.SOLANA}, withKey(NewSolana)},
+		{Info{Name: _aptos_name, Symbol: _aptos_symbol, Decimals: _aptos_decimals, SignatureType: signer.APTOS}, withKey(NewAptos)},
+		{Info{Name: _near_name, Symbol: _near_symbol, Decimals: _near_decimals, SignatureType: signer.NEAR}, func(opts Options) (Currency, error) {
+			return NewNear(opts.AccountId, opts.PrivateKey, opts.RPC)
+		}},
+		{Info{Name: _algorand_name, Symbol: _algorand_symbol, Decimals: _algorand_decimals, SignatureType: signer.ALGORAND}, withKey(NewAlgorand)},
+	} {
+		Register(r.info, r.factory)
+	}
+}
+
+// Register add currency to registry by name of info, so it can be created with New.
+// it panics if name is empty or already registered, so it's expected to be called from init of package.
+//
+// Example:
+//
+//	func init() {
+//		currency.Register(currency.Info{
+//			Name:          "mychain",
+//			Symbol:        "myc",
+//			Decimals:      9,
+//			SignatureType: signer.ED25519,
+//		}, func(opts currency.Options) (currency.Currency, error) {
+//			return NewMyChain(opts.PrivateKey, opts.RPC)
+//		})
+//	}
+func Register(info Info, factory Factory) {
+	if len(info.Name) == 0 || factory == nil {
+		panic("currency: register with empty name or nil factory")
+	}
+
+	_registryMu.Lock()
+	defer _registryMu.Unlock()
+
+	if _, ok := _registry[info.Name]; ok {
+		panic(fmt.Sprintf("currency: %s is already registered", info.Name))
+	}
+	_registry[info.Name] = registration{info: info, factory: factory}
+}
+
+// RegisterEVM add evm chain currency to registry, created currency is same as NewEVM
+func RegisterEVM(config EVMConfig) {
+	decimals := config.Decimals
+	if decimals == 0 {
+		decimals = _evm_decimals
+	}
+
+	Register(Info{
+		Name:          config.Name,
+		Symbol:        config.Symbol,
+		Decimals:      decimals,
+		SignatureType: signer.Ethereum,
+	}, func(opts Options) (Currency, error) {
+		return NewEVM(config, opts.PrivateKey, opts.RPC)
+	})
+}
+
+// New create registered currency by irys name (e.g. matic)
+func New(name string, opts Options) (Currency, error) {
+	_registryMu.RLock()
+	r, ok := _registry[name]
+	_registryMu.RUnlock()
+
+	if !ok {
+		return nil, errors.ErrCurrencyIsInvalid
+	}
+	return r.factory(opts)
+}
+
+// Registered return sorted names of registered currencies
+func Registered() []string {
+	_registryMu.RLock()
+	defer _registryMu.RUnlock()
+
+	names := make([]string, 0, len(_registry))
+	for name := range _registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func withKey(constructor func(privateKey, rpc string) (Currency, error)) Factory {
+	return func(opts Options) (Currency, error) {
+		return constructor(opts.PrivateKey, opts.RPC)
+	}
+}
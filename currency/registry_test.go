@@ -0,0 +1,34 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	require.Contains(t, Registered(), "matic")
+	require.Contains(t, Registered(), "algorand")
+
+	info, err := GetInfo("near")
+	require.NoError(t, err)
+	require.Equal(t, _near_decimals, info.Decimals)
+
+	_, err = New("unknown", Options{})
+	require.ErrorIs(t, err, errors.ErrCurrencyIsInvalid)
+
+	require.Panics(t, func() {
+		Register(Info{Name: "matic"}, withKey(NewMatic))
+	})
+
+	RegisterEVM(EVMConfig{Name: "registry-test-eth", Chain: "test", Symbol: "eth"})
+	info, err = GetInfo("registry-test-eth")
+	require.NoError(t, err)
+	require.Equal(t, _evm_decimals, info.Decimals)
+	require.Equal(t, signer.Ethereum, info.SignatureType)
+
+	_, err = New("registry-test-eth", Options{})
+	require.ErrorIs(t, err, errors.ErrPrivateKeyIsEmpty)
+}
@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// restCall do http request to rest api of chain node and decode json response into result
+func restCall(ctx context.Context, method, url, contentType string, body []byte, result any) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if len(contentType) != 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := _rpcHttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		b, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if err != nil {
+			return err
+		}
+		return &restStatusError{method: method, url: url, statusCode: resp.StatusCode, body: string(b)}
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// restStatusError is returned by restCall when node respond with error status
+type restStatusError struct {
+	method     string
+	url        string
+	statusCode int
+	body       string
+}
+
+func (e *restStatusError) Error() string {
+	return fmt.Sprintf("%s %s: %d: %s", e.method, e.url, e.statusCode, e.body)
+}
+
+// isNotFound check error is not found response of rest api
+func isNotFound(err error) bool {
+	var statusErr *restStatusError
+	return errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound
+}
@@ -0,0 +1,236 @@
+package currency
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+	"github.com/Ja7ad/irys/utils/base58"
+)
+
+const (
+	_solana_name   = "solana"
+	_solana_chain  = "solana"
+	_solana_symbol = "sol"
+	// 1 sol is 10^9 lamports
+	_solana_decimals = 9
+
+	_solana_transfer_instruction = 2
+)
+
+// system program id is 32 zero bytes (base58 "11111111111111111111111111111111")
+var _solana_system_program = make([]byte, ed25519.PublicKeySize)
+
+type Solana struct {
+	chain     string
+	symbol    string
+	name      string
+	rpc       string
+	tokenType CurrencyType
+	signer    *signer.SolanaSigner
+}
+
+// NewSolana create solana currency object by base58 private key and json-rpc address
+func NewSolana(privateKey, rpc string) (Currency, error) {
+	if len(privateKey) == 0 {
+		return nil, errors.ErrPrivateKeyIsEmpty
+	}
+
+	s, err := signer.NewSolanaSigner(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Solana{
+		chain:     _solana_chain,
+		symbol:    _solana_symbol,
+		name:      _solana_name,
+		rpc:       rpc,
+		tokenType: SOLANA,
+		signer:    s,
+	}, nil
+}
+
+func (s *Solana) GetChain() string {
+	return s.chain
+}
+
+func (s *Solana) GetSymbol() string {
+	return s.symbol
+}
+
+func (s *Solana) GetName() string {
+	return s.name
+}
+
+func (s *Solana) Decimals() int {
+	return _solana_decimals
+}
+
+func (s *Solana) GetAddress() string {
+	return s.signer.Address()
+}
+
+func (s *Solana) GetSinger() signer.Signer {
+	return s.signer
+}
+
+func (s *Solana) GetRPCAddr() string {
+	return s.rpc
+}
+
+func (s *Solana) GetType() CurrencyType {
+	return s.tokenType
+}
+
+// SendFunds transfer amount lamports to base58 address with system program and return transaction signature
+func (s *Solana) SendFunds(ctx context.Context, to string, amount *big.Int) (string, error) {
+	if len(s.rpc) == 0 {
+		return "", errors.ErrSignOnlyCurrency
+	}
+
+	toKey, err := base58.Decode(to)
+	if err != nil {
+		return "", err
+	}
+	if len(toKey) != ed25519.PublicKeySize {
+		return "", errors.ErrInvalidAddress
+	}
+
+	if amount == nil || amount.Sign() <= 0 {
+		return "", errors.ErrAmountNotPositive
+	}
+	if !amount.IsUint64() {
+		return "", errors.ErrInvalidAmount
+	}
+
+	var blockhash struct {
+		Value struct {
+			Blockhash string `json:"blockhash"`
+		} `json:"value"`
+	}
+	if err := callJSONRPC(ctx, s.rpc, "getLatestBlockhash", []any{
+		map[string]string{"commitment": "finalized"},
+	}, &blockhash); err != nil {
+		return "", err
+	}
+
+	recentBlockhash, err := base58.Decode(blockhash.Value.Blockhash)
+	if err != nil {
+		return "", err
+	}
+
+	message := solanaTransferMessage(s.signer.Owner, toKey, recentBlockhash, amount.Uint64())
+
+	// transactions are signed over raw message, signer of data items sign hex of message
+	signature := ed25519.Sign(s.signer.PrivateKey, message)
+
+	tx := append(solanaCompactU16(1), signature...)
+	tx = append(tx, message...)
+
+	var txSignature string
+	if err := callJSONRPC(ctx, s.rpc, "sendTransaction", []any{
+		base64.StdEncoding.EncodeToString(tx),
+		map[string]string{"encoding": "base64"},
+	}, &txSignature); err != nil {
+		return "", err
+	}
+
+	return txSignature, nil
+}
+
+// solanaTransferMessage build legacy transaction message with one system program transfer instruction
+func solanaTransferMessage(from, to, recentBlockhash []byte, lamports uint64) []byte {
+	// header: required signatures, readonly signed accounts, readonly unsigned accounts
+	message := []byte{1, 0, 1}
+
+	message = append(message, solanaCompactU16(3)...)
+	message = append(message, from...)
+	message = append(message, to...)
+	message = append(message, _solana_system_program...)
+
+	message = append(message, recentBlockhash...)
+
+	data := make([]byte, 12)
+	binary.LittleEndian.PutUint32(data[:4], _solana_transfer_instruction)
+	binary.LittleEndian.PutUint64(data[4:], lamports)
+
+	message = append(message, solanaCompactU16(1)...)
+	message = append(message, 2) // program id index
+	message = append(message, solanaCompactU16(2)...)
+	message = append(message, 0, 1) // from, to account index
+	message = append(message, solanaCompactU16(len(data))...)
+	message = append(message, data...)
+
+	return message
+}
+
+func solanaCompactU16(n int) []byte {
+	var out []byte
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+// GetWalletBalance return balance of wallet in lamports
+func (s *Solana) GetWalletBalance(ctx context.Context) (*big.Int, error) {
+	if len(s.rpc) == 0 {
+		return nil, errors.ErrSignOnlyCurrency
+	}
+
+	var balance struct {
+		Value uint64 `json:"value"`
+	}
+	if err := callJSONRPC(ctx, s.rpc, "getBalance", []any{
+		s.GetAddress(),
+		map[string]string{"commitment": "confirmed"},
+	}, &balance); err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetUint64(balance.Value), nil
+}
+
+// WaitForConfirmation wait until transaction signature is confirmed, failed transaction return error
+func (s *Solana) WaitForConfirmation(ctx context.Context, txId string) error {
+	if len(s.rpc) == 0 {
+		return errors.ErrSignOnlyCurrency
+	}
+
+	return pollConfirmation(ctx, func() (bool, error) {
+		var statuses struct {
+			Value []*struct {
+				ConfirmationStatus string          `json:"confirmationStatus"`
+				Err                json.RawMessage `json:"err"`
+			} `json:"value"`
+		}
+		if err := callJSONRPC(ctx, s.rpc, "getSignatureStatuses", []any{
+			[]string{txId},
+			map[string]bool{"searchTransactionHistory": true},
+		}, &statuses); err != nil {
+			return false, err
+		}
+
+		if len(statuses.Value) == 0 || statuses.Value[0] == nil {
+			return false, nil
+		}
+
+		status := statuses.Value[0]
+		if len(status.Err) != 0 && string(status.Err) != "null" {
+			return false, fmt.Errorf("solana transaction %s failed: %s", txId, string(status.Err))
+		}
+
+		return status.ConfirmationStatus == "confirmed" || status.ConfirmationStatus == "finalized", nil
+	})
+}
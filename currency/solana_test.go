@@ -0,0 +1,51 @@
+package currency
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+const _test_solana_private_key = "2Ana1pUpv2ZbMVkwF5FXapYeBEjdxDatLn7nvJkhgTSdZd8hbDHTd21as7EAsg7ypityqfsw2pMQKJcVDVcAEsd"
+
+func TestSolanaCompactU16(t *testing.T) {
+	require.Equal(t, []byte{0x00}, solanaCompactU16(0))
+	require.Equal(t, []byte{0x7f}, solanaCompactU16(0x7f))
+	require.Equal(t, []byte{0x80, 0x01}, solanaCompactU16(0x80))
+	require.Equal(t, []byte{0xff, 0xff, 0x03}, solanaCompactU16(0xffff))
+}
+
+func TestSolanaTransferMessage(t *testing.T) {
+	from := make([]byte, 32)
+	to := make([]byte, 32)
+	blockhash := make([]byte, 32)
+	for i := range from {
+		from[i], to[i], blockhash[i] = 1, 2, 3
+	}
+
+	message := solanaTransferMessage(from, to, blockhash, 5000)
+	require.Len(t, message, 150)
+	require.Equal(t, []byte{1, 0, 1, 3}, message[:4])
+	require.Equal(t, from, message[4:36])
+	require.Equal(t, to, message[36:68])
+	require.Equal(t, _solana_system_program, message[68:100])
+	require.Equal(t, blockhash, message[100:132])
+	require.Equal(t, []byte{1, 2, 2, 0, 1, 12}, message[132:138])
+	require.Equal(t, uint32(_solana_transfer_instruction), binary.LittleEndian.Uint32(message[138:142]))
+	require.Equal(t, uint64(5000), binary.LittleEndian.Uint64(message[142:]))
+}
+
+func TestSolana_SendFundsNonPositive(t *testing.T) {
+	c, err := NewSolana(_test_solana_private_key, "http://127.0.0.1:1")
+	require.NoError(t, err)
+	sol := c.(*Solana)
+
+	for _, amount := range []*big.Int{nil, big.NewInt(0), big.NewInt(-1)} {
+		_, err := sol.SendFunds(context.Background(), sol.GetAddress(), amount)
+		require.ErrorIs(t, err, errors.ErrAmountNotPositive)
+	}
+}
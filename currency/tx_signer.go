@@ -0,0 +1,39 @@
+package currency
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/Ja7ad/irys/signer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TxSigner sign evm funding transactions, it can be backed by local key or remote signer
+type TxSigner interface {
+	// Address return account address of signer
+	Address() common.Address
+	// SignTx sign transaction for chain id and return signed transaction
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// EVMSigner sign both data items and funding transactions (e.g. signer.ClefSigner)
+type EVMSigner interface {
+	signer.Signer
+	TxSigner
+}
+
+// localTxSigner sign transactions with in-memory private key
+type localTxSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (l *localTxSigner) Address() common.Address {
+	return crypto.PubkeyToAddress(l.key.PublicKey)
+}
+
+func (l *localTxSigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.NewEIP155Signer(chainID), l.key)
+}
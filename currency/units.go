@@ -0,0 +1,112 @@
+package currency
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+)
+
+// FormatUnits convert atomic amount (wei, winston, ...) to decimal string base on decimals
+//
+// Example: FormatUnits(big.NewInt(1500000), 6) return "1.5"
+func FormatUnits(amount *big.Int, decimals int) string {
+	if amount == nil {
+		return "0"
+	}
+
+	neg := amount.Sign() < 0
+	digits := new(big.Int).Abs(amount).String()
+
+	if decimals > 0 {
+		if len(digits) <= decimals {
+			digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+		}
+		point := len(digits) - decimals
+		fraction := strings.TrimRight(digits[point:], "0")
+		digits = digits[:point]
+		if len(fraction) != 0 {
+			digits += "." + fraction
+		}
+	}
+
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+// ParseUnits convert decimal string to atomic amount base on decimals without float rounding,
+// return error if value has more fraction digits than decimals
+//
+// Example: ParseUnits("1.5", 6) return 1500000
+func ParseUnits(value string, decimals int) (*big.Int, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	neg := false
+	switch value[0] {
+	case '-':
+		neg = true
+		value = value[1:]
+	case '+':
+		value = value[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	if len(whole) == 0 && len(fraction) == 0 {
+		return nil, errors.ErrInvalidAmount
+	}
+	if len(fraction) > decimals {
+		return nil, errors.ErrAmountTooPrecise
+	}
+
+	digits := whole + fraction + strings.Repeat("0", decimals-len(fraction))
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return nil, errors.ErrInvalidAmount
+		}
+	}
+
+	amount, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	if neg {
+		amount.Neg(amount)
+	}
+	return amount, nil
+}
+
+// ParseAmount convert amount string of currency like "0.5 MATIC" or "0.5" to atomic amount,
+// symbol is optional and compared case-insensitive with currency symbol, amount must be positive
+func ParseAmount(c Currency, amount string) (*big.Int, error) {
+	fields := strings.Fields(amount)
+	switch len(fields) {
+	case 1:
+	case 2:
+		if !strings.EqualFold(fields[1], c.GetSymbol()) {
+			return nil, errors.ErrAmountSymbolMismatch
+		}
+	default:
+		return nil, errors.ErrInvalidAmount
+	}
+
+	atomic, err := ParseUnits(fields[0], c.Decimals())
+	if err != nil {
+		return nil, err
+	}
+	if atomic.Sign() <= 0 {
+		return nil, errors.ErrAmountNotPositive
+	}
+
+	return atomic, nil
+}
+
+// FormatAmount convert atomic amount to decimal string with currency symbol like "0.5 MATIC"
+func FormatAmount(c Currency, amount *big.Int) string {
+	return FormatUnits(amount, c.Decimals()) + " " + strings.ToUpper(c.GetSymbol())
+}
@@ -0,0 +1,74 @@
+package currency
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals int
+		want     string
+	}{
+		{"0", 18, "0"},
+		{"1500000", 6, "1.5"},
+		{"1", 18, "0.000000000000000001"},
+		{"1000000000000000000", 18, "1"},
+		{"-250", 2, "-2.5"},
+		{"42", 0, "42"},
+	}
+
+	for _, tt := range tests {
+		amount, _ := new(big.Int).SetString(tt.amount, 10)
+		require.Equal(t, tt.want, FormatUnits(amount, tt.decimals))
+	}
+}
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals int
+		want     string
+		err      error
+	}{
+		{"1.5", 6, "1500000", nil},
+		{"0.000000000000000001", 18, "1", nil},
+		{".5", 1, "5", nil},
+		{"-2.5", 2, "-250", nil},
+		{"0.1234567", 6, "", errors.ErrAmountTooPrecise},
+		{"1e5", 6, "", errors.ErrInvalidAmount},
+		{"", 6, "", errors.ErrInvalidAmount},
+		{".", 6, "", errors.ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		amount, err := ParseUnits(tt.value, tt.decimals)
+		if tt.err != nil {
+			require.ErrorIs(t, err, tt.err, tt.value)
+			continue
+		}
+		require.NoError(t, err, tt.value)
+		require.Equal(t, tt.want, amount.String())
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	arweave := &Arweave{symbol: _arweave_symbol}
+
+	amount, err := ParseAmount(arweave, "0.5 AR")
+	require.NoError(t, err)
+	require.Equal(t, "500000000000", amount.String())
+	require.Equal(t, "0.5 AR", FormatAmount(arweave, amount))
+
+	_, err = ParseAmount(arweave, "0.5 MATIC")
+	require.ErrorIs(t, err, errors.ErrAmountSymbolMismatch)
+
+	for _, amount := range []string{"-1 AR", "0", "-0.5", "0.000 AR"} {
+		_, err = ParseAmount(arweave, amount)
+		require.ErrorIs(t, err, errors.ErrAmountNotPositive, amount)
+	}
+}
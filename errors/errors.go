@@ -19,8 +19,16 @@ var (
 	ErrNotEnoughBytesForNumberOfTags     = errors.New("not enough bytes for the number of tags")
 	ErrNotEnoughBytesForNumberOfTagBytes = errors.New("not enough bytes for the number of tag bytes")
 	ErrNotEnoughBytesForTags             = errors.New("not enough bytes for tags")
+	ErrInvalidTargetFlag                 = errors.New("target flag must be 0 or 1")
+	ErrInvalidAnchorFlag                 = errors.New("anchor flag must be 0 or 1")
+	ErrInvalidTags                       = errors.New("tags are not valid avro array")
+	ErrTagsCountMismatch                 = errors.New("number of tags doesn't match encoded tags")
+	ErrUnsupportedTagValue               = errors.New("value can't be converted to tag, it must be struct of strings, bools or numbers")
+	ErrDataTooLarge                      = errors.New("data is larger than max data size")
 	ErrVerifyIdSignatureMismatch         = errors.New("id doesn't match signature")
 	ErrVerifyBadAnchorLength             = errors.New("anchor must be 32 bytes long")
+	ErrVerifyBadTargetLength             = errors.New("target must be 32 bytes long")
+	ErrDataItemTooLarge                  = errors.New("data item is larger than max item size")
 	ErrVerifyTooManyTags                 = errors.New("too many tags, max is 128")
 	ErrVerifyEmptyTagName                = errors.New("tag name is empty")
 	ErrVerifyTooLongTagName              = errors.New("tag name is too long, max is 1024 bytes")
@@ -29,10 +37,34 @@ var (
 	ErrVerifyTooManyTagsBytes            = errors.New("serialized tags are too long, max is 4KB")
 	ErrBufferTooSmall                    = errors.New("buffer too small")
 	ErrUnsupportedSignatureType          = errors.New("unsupported signature type")
+	ErrInvalidEd25519PrivateKey          = errors.New("ed25519 private key must be 32 bytes seed or 64 bytes key")
+	ErrEd25519SignatureMismatch          = errors.New("ed25519 signature mismatch")
 	ErrFailedToParseEthereumPublicKey    = errors.New("failed to parse ethereum public key")
 	ErrNotSigned                         = errors.New("bundle item not signed")
 	ErrNestedBundleInvalidLength         = errors.New("nested bundle invalid length in one of the fields")
 	ErrBalanceIsLow                      = errors.New("balance is low")
 	ErrNotEnoughBalance                  = errors.New("not enough balance")
 	ErrNotAllowedChunkSize               = errors.New("chunk size file is greater 95 MB or lesser 500 KB")
+	ErrInvalidAddress                    = errors.New("address is invalid for currency")
+	ErrInvalidAmount                     = errors.New("amount is not a valid decimal number")
+	ErrAmountTooPrecise                  = errors.New("amount has more fraction digits than currency decimals")
+	ErrAmountNotPositive                 = errors.New("amount must be greater than zero")
+	ErrAmountSymbolMismatch              = errors.New("amount symbol doesn't match currency symbol")
+	ErrFundingLimitExceeded              = errors.New("top up amount exceed max spend of funding policy")
+	ErrInvalidMnemonic                   = errors.New("mnemonic must have 12, 15, 18, 21 or 24 words")
+	ErrMnemonicUnknownWord               = errors.New("mnemonic word is not in bip-39 english wordlist")
+	ErrMnemonicChecksum                  = errors.New("mnemonic checksum is invalid")
+	ErrInvalidDerivedKey                 = errors.New("derived key is invalid, use next index")
+	ErrInvalidArweaveKey                 = errors.New("arweave key must be rsa private key in JWK or PEM (PKCS#1, PKCS#8) format")
+	ErrInvalidArweaveKeySize             = errors.New("arweave key must be 4096 bits rsa key")
+	ErrArweaveKeySetSize                 = errors.New("arweave wallet must contain exactly one key")
+	ErrArweaveSignatureMismatch          = errors.New("arweave signature mismatch")
+	ErrInvalidSignatureLength            = errors.New("signature length doesn't match signature type")
+	ErrInvalidOwnerLength                = errors.New("owner length doesn't match signature type")
+	ErrMultiSignatureThreshold           = errors.New("count of valid signatures is lower than threshold")
+	ErrSignOnlyCurrency                  = errors.New("currency is sign only, rpc address is required for funding")
+	ErrSignerIsEmpty                     = errors.New("signer is empty")
+	ErrRemoteSignerEndpointIsEmpty       = errors.New("remote signer endpoint is empty")
+	ErrRemoteSignerAccountMismatch       = errors.New("remote signer signed with different account")
+	ErrRemoteSignerTxMismatch            = errors.New("remote signer returned different transaction")
 )
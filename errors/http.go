@@ -0,0 +1,95 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+var (
+	ErrNotFound        = errors.New("resource not found")
+	ErrRateLimited     = errors.New("rate limited by node")
+	ErrPayloadTooLarge = errors.New("payload is too large for node")
+	ErrNodeUnavailable = errors.New("node is unavailable")
+
+	ErrResponseTooLarge      = errors.New("response body is larger than max response size")
+	ErrUnexpectedContentType = errors.New("response content type is not json")
+	ErrInvalidResponse       = errors.New("response body is not valid")
+)
+
+// HTTPError is returned when node or gateway respond with error status code, common status codes
+// wrap sentinel error (e.g. ErrNotFound, ErrRateLimited) which can be checked with errors.Is
+type HTTPError struct {
+	StatusCode int
+	Body       string
+	Endpoint   string
+	RequestID  string
+}
+
+func (e *HTTPError) Error() string {
+	msg := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if len(e.Endpoint) != 0 {
+		msg = e.Endpoint + ": " + msg
+	}
+	if len(e.Body) != 0 {
+		msg += ": " + e.Body
+	}
+	if len(e.RequestID) != 0 {
+		msg += " (request id " + e.RequestID + ")"
+	}
+	return msg
+}
+
+// Unwrap return sentinel error of status code or nil
+func (e *HTTPError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusPaymentRequired:
+		return ErrNotEnoughBalance
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusRequestEntityTooLarge:
+		return ErrPayloadTooLarge
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return ErrNodeUnavailable
+	}
+	return nil
+}
+
+// IsRetryable report whether request failed with err can be sent again, e.g. rate limit, 5xx status
+// codes and timeouts of each attempt (http client timeout or dial timeout). canceled or expired context
+// of caller and client errors (4xx) aren't retryable
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || isContextDeadline(err) {
+		return false
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		switch {
+		case httpErr.StatusCode == http.StatusRequestTimeout, httpErr.StatusCode == http.StatusTooManyRequests:
+			return true
+		case httpErr.StatusCode == http.StatusNotImplemented:
+			return false
+		default:
+			return httpErr.StatusCode >= http.StatusInternalServerError
+		}
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isContextDeadline report whether err is expired deadline of ctx, timeout of http client only match
+// context.DeadlineExceeded with errors.Is but isn't context.DeadlineExceeded itself
+func isContextDeadline(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if err == context.DeadlineExceeded {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPError(t *testing.T) {
+	err := fmt.Errorf("get price: %w", &HTTPError{
+		StatusCode: http.StatusTooManyRequests,
+		Body:       "slow down",
+		Endpoint:   "GET https://node1.irys.xyz/price/matic/100",
+		RequestID:  "abc",
+	})
+
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusTooManyRequests, httpErr.StatusCode)
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, "get price: GET https://node1.irys.xyz/price/matic/100: 429 Too Many Requests: slow down (request id abc)", err.Error())
+
+	require.ErrorIs(t, &HTTPError{StatusCode: http.StatusNotFound}, ErrNotFound)
+	require.ErrorIs(t, &HTTPError{StatusCode: http.StatusPaymentRequired}, ErrNotEnoughBalance)
+	require.ErrorIs(t, &HTTPError{StatusCode: http.StatusRequestEntityTooLarge}, ErrPayloadTooLarge)
+	require.ErrorIs(t, &HTTPError{StatusCode: http.StatusServiceUnavailable}, ErrNodeUnavailable)
+	require.Nil(t, errors.Unwrap(&HTTPError{StatusCode: http.StatusBadRequest}))
+}
+
+func TestIsRetryable(t *testing.T) {
+	require.False(t, IsRetryable(nil))
+	require.False(t, IsRetryable(context.Canceled))
+	require.False(t, IsRetryable(ErrNotEnoughBalance))
+	require.False(t, IsRetryable(&HTTPError{StatusCode: http.StatusBadRequest}))
+	require.False(t, IsRetryable(&HTTPError{StatusCode: http.StatusNotFound}))
+	require.False(t, IsRetryable(&HTTPError{StatusCode: http.StatusNotImplemented}))
+
+	require.True(t, IsRetryable(&HTTPError{StatusCode: http.StatusTooManyRequests}))
+	require.True(t, IsRetryable(&HTTPError{StatusCode: http.StatusRequestTimeout}))
+	require.True(t, IsRetryable(&HTTPError{StatusCode: http.StatusInternalServerError}))
+	require.True(t, IsRetryable(fmt.Errorf("upload: %w", &HTTPError{StatusCode: http.StatusBadGateway})))
+
+	// deadline of caller ctx, while waiting for backoff or during request
+	require.False(t, IsRetryable(context.DeadlineExceeded))
+	require.False(t, IsRetryable(&url.Error{Op: "Get", URL: "http://node", Err: context.DeadlineExceeded}))
+}
+
+func TestIsRetryable_ClientTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Timeout: 10 * time.Millisecond}
+	_, err := client.Get(srv.URL)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, IsRetryable(err))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	_, err = http.DefaultClient.Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, IsRetryable(err))
+}
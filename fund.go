@@ -6,87 +6,51 @@ import (
 
 	"github.com/Ja7ad/irys/currency"
 	"github.com/Ja7ad/irys/errors"
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"golang.org/x/crypto/sha3"
 )
 
+// createTx send amount from currency wallet to irys node address, currency must implement currency.Funder
 func (c *Client) createTx(ctx context.Context, amount *big.Int) (string, error) {
-	switch c.currency.GetType() {
-	case currency.ETHEREUM, currency.MATIC, currency.AVALANCHE, currency.FANTOM, currency.BNB, currency.ARBITRUM:
-		c.debugMsg("[Transaction] create ethereum transaction")
-		hash, err := createEthTx(ctx, c, amount)
-		if err != nil {
-			return "", err
-		}
-		c.debugMsg("[Transaction] transaction with hash %s done", hash)
-		return hash, nil
-	// TODO: arweave not supported currently
-	case currency.ARWEAVE:
-
-	}
-	return "", errors.ErrTokenNotSupported
-}
-
-func createEthTx(ctx context.Context, i *Client, amount *big.Int) (string, error) {
-	pubKey := i.currency.GetPublicKey()
-	client := i.currency.GetRPCClient()
-	fromAddress := crypto.PubkeyToAddress(*pubKey)
-	toAddress := common.HexToAddress(i.contract)
-
-	gasPrice, err := client.SuggestGasPrice(ctx)
-	if err != nil {
-		return "", err
+	funder, ok := c.currency.(currency.Funder)
+	if !ok {
+		return "", errors.ErrTokenNotSupported
 	}
 
-	chainID, err := client.ChainID(ctx)
+	c.debugMsg("[Transaction] create %s transaction", c.currency.GetName())
+	hash, err := funder.SendFunds(ctx, c.contract, amount)
 	if err != nil {
 		return "", err
 	}
+	c.debugMsg("[Transaction] transaction with hash %s done", hash)
 
-	var data []byte
-	transferFnSignature := []byte("transfer(address,uint256)")
-	hash := sha3.NewLegacyKeccak256()
-	hash.Write(transferFnSignature)
-	methodID := hash.Sum(nil)[:4]
-	data = append(data, methodID...)
-	paddedAddress := common.LeftPadBytes(toAddress.Bytes(), 32)
-	data = append(data, paddedAddress...)
-	paddedAmount := common.LeftPadBytes(amount.Bytes(), 32)
-	data = append(data, paddedAmount...)
+	return hash, nil
+}
 
-	gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{
-		To:   &toAddress,
-		Data: data,
-	})
-	if err != nil {
-		return "", err
+// confirmTx block until transaction of currency wallet is confirmed, so node can credit it and
+// funder stop tracking it as pending
+func (c *Client) confirmTx(ctx context.Context, hash string) error {
+	funder, ok := c.currency.(currency.Funder)
+	if !ok {
+		return errors.ErrTokenNotSupported
 	}
 
-	nonce, err := client.PendingNonceAt(ctx, fromAddress)
-	if err != nil {
-		return "", err
+	if err := funder.WaitForConfirmation(ctx, hash); err != nil {
+		return err
 	}
+	c.debugMsg("[Transaction] transaction with hash %s confirmed", hash)
 
-	tx := types.NewTransaction(
-		nonce,
-		common.HexToAddress(i.contract),
-		amount,
-		gasLimit,
-		gasPrice,
-		data,
-	)
+	return nil
+}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), i.currency.GetPrivateKey())
-	if err != nil {
-		return "", err
+func (c *Client) speedUpTxs(ctx context.Context) ([]string, error) {
+	replacer, ok := c.currency.(currency.PendingReplacer)
+	if !ok {
+		return nil, errors.ErrTokenNotSupported
 	}
 
-	if err = client.SendTransaction(ctx, signedTx); err != nil {
-		return "", err
+	hashes, err := replacer.SpeedUpPending(ctx)
+	for _, hash := range hashes {
+		c.debugMsg("[Transaction] pending transaction replaced by %s", hash)
 	}
 
-	return signedTx.Hash().Hex(), nil
+	return hashes, err
 }
@@ -2,51 +2,67 @@ package irys
 
 import (
 	"crypto/rand"
-	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/Ja7ad/irys/errors"
 	"github.com/Ja7ad/irys/signer"
 	"github.com/Ja7ad/irys/types"
 )
 
-func decodeBody[T any](body io.Reader) (T, error) {
-	var resp T
-	d := json.NewDecoder(body)
-	return resp, d.Decode(&resp)
-}
+const (
+	_maxErrorBodySize = 4096
+	_requestIDHeader  = "X-Request-Id"
+)
 
 func addContentType(contentType string, tags ...types.Tag) types.Tags {
-	found := false
-	for _, tag := range tags {
-		if tag.Name == "Content-Type" {
-			found = true
-		}
-	}
-
-	if !found {
+	if _, found := types.Tags(tags).Get("Content-Type"); !found {
 		tags = append(tags, types.Tag{Name: "Content-Type", Value: contentType})
 	}
 
 	return tags
 }
 
+// statusCheck return *errors.HTTPError for error status codes of response
 func statusCheck(resp *http.Response) error {
-	switch {
-	case resp.StatusCode == http.StatusPaymentRequired:
-		return errors.ErrNotEnoughBalance
-	case resp.StatusCode >= http.StatusBadRequest:
-		b, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return err
-		}
-		return fmt.Errorf("%d: %s", resp.StatusCode, string(b))
-	case resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted:
+	if resp.StatusCode < http.StatusBadRequest {
 		return nil
 	}
-	return nil
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, _maxErrorBodySize))
+	if err != nil {
+		return err
+	}
+
+	httpErr := &errors.HTTPError{
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(b)),
+		RequestID:  resp.Header.Get(_requestIDHeader),
+	}
+	if resp.Request != nil && resp.Request.URL != nil {
+		endpoint := *resp.Request.URL
+		endpoint.RawQuery = ""
+		httpErr.Endpoint = resp.Request.Method + " " + endpoint.String()
+	}
+
+	return httpErr
+}
+
+// validateUpload check data item created by signFile against ANS-104 and node limits before any network call
+func (c *Client) validateUpload(file []byte, tags ...types.Tag) error {
+	dataItem := types.BundleItem{
+		SignatureType: c.currency.GetSinger().GetType(),
+		Data:          types.Base64String(file),
+		Tags:          addContentType(http.DetectContentType(file), tags...),
+		Anchor:        make([]byte, 32),
+	}
+
+	if err := dataItem.Validate(); err != nil {
+		return err
+	}
+
+	return types.ValidateSize(dataItem.Size(), c.maxItemSize)
 }
 
 func signFile(file []byte, signer signer.Signer, withAnchor bool, tags ...types.Tag) ([]byte, error) {
@@ -0,0 +1,129 @@
+package irys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Ja7ad/irys/signer"
+	"github.com/Ja7ad/irys/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_test_currency         = "fake"
+	_test_node_address     = "0x853758425e953739F5438fd6fd0Efe04A477b039"
+	_test_evm_private_key  = "8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f"
+	_test_retry_wait_short = time.Millisecond
+)
+
+// fakeCurrency is currency which record sent funds instead of sending transactions
+type fakeCurrency struct {
+	signer signer.Signer
+
+	mu         sync.Mutex
+	sends      []*big.Int
+	confirmed  []string
+	confirmErr error
+}
+
+func newFakeCurrency(t *testing.T) *fakeCurrency {
+	s, err := signer.NewEthereumSigner("0x" + _test_evm_private_key)
+	require.NoError(t, err)
+	return &fakeCurrency{signer: s}
+}
+
+func (f *fakeCurrency) GetName() string          { return _test_currency }
+func (f *fakeCurrency) GetChain() string         { return _test_currency }
+func (f *fakeCurrency) GetSymbol() string        { return _test_currency }
+func (f *fakeCurrency) Decimals() int            { return 18 }
+func (f *fakeCurrency) GetAddress() string       { return _test_node_address }
+func (f *fakeCurrency) GetSinger() signer.Signer { return f.signer }
+func (f *fakeCurrency) GetRPCAddr() string       { return "" }
+
+func (f *fakeCurrency) GetWalletBalance(_ context.Context) (*big.Int, error) {
+	return new(big.Int), nil
+}
+
+func (f *fakeCurrency) SendFunds(_ context.Context, _ string, amount *big.Int) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sends = append(f.sends, new(big.Int).Set(amount))
+	return fmt.Sprintf("tx-%d", len(f.sends)), nil
+}
+
+func (f *fakeCurrency) WaitForConfirmation(_ context.Context, txId string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.confirmErr != nil {
+		return f.confirmErr
+	}
+	f.confirmed = append(f.confirmed, txId)
+	return nil
+}
+
+func (f *fakeCurrency) sent() []*big.Int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*big.Int(nil), f.sends...)
+}
+
+// newTestClient create client of httptest node, mux serve node endpoints except node info
+func newTestClient(t *testing.T, mux *http.ServeMux, cur *fakeCurrency, options ...Option) *Client {
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, types.NodeInfo{Addresses: map[string]string{_test_currency: _test_node_address}})
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	options = append([]Option{
+		WithCustomRetryWaitMin(_test_retry_wait_short),
+		WithCustomRetryWaitMax(_test_retry_wait_short),
+	}, options...)
+
+	c, err := New(Node(srv.URL), cur, false, options...)
+	require.NoError(t, err)
+	t.Cleanup(c.(*Client).Close)
+
+	return c.(*Client)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// flakyStatus respond status to first failures requests of handler
+func flakyStatus(failures int32, status int, next http.HandlerFunc) http.HandlerFunc {
+	var calls atomic.Int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func TestAddContentType(t *testing.T) {
+	tags := addContentType("text/plain", types.Tag{Name: "content-type", Value: "image/png"})
+	require.Equal(t, types.Tags{{Name: "content-type", Value: "image/png"}}, tags)
+
+	tags = addContentType("text/plain", types.Tag{Name: "App-Name", Value: "irys-go"})
+	require.Equal(t, types.Tags{
+		{Name: "App-Name", Value: "irys-go"},
+		{Name: "Content-Type", Value: "text/plain"},
+	}, tags)
+}
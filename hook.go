@@ -0,0 +1,51 @@
+package irys
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Hooks are called for every request of client to node and gateway (including chunk requests), nil hooks are skipped
+type Hooks struct {
+	// BeforeSend is called once before request is sent, headers of request can be changed (e.g. auth header,
+	// User-Agent or request id) and returned error cancel request
+	BeforeSend func(req *http.Request) error
+	// AfterReceive is called with final response or error of request after retries, resp is nil on error
+	// and its body must not be read
+	AfterReceive func(req *http.Request, resp *http.Response, err error)
+	// OnRetry is called before request is sent again, attempt start from 1
+	OnRetry func(req *http.Request, attempt int)
+}
+
+func (c *Client) beforeSend(req *http.Request) error {
+	for _, h := range c.hooks {
+		if h.BeforeSend == nil {
+			continue
+		}
+		if err := h.BeforeSend(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) afterReceive(req *http.Request, resp *http.Response, err error) {
+	for _, h := range c.hooks {
+		if h.AfterReceive != nil {
+			h.AfterReceive(req, resp, err)
+		}
+	}
+}
+
+// retryHook is request log hook of retryablehttp which is called before each attempt of request
+func (c *Client) retryHook(_ retryablehttp.Logger, req *http.Request, attempt int) {
+	if attempt == 0 {
+		return
+	}
+	for _, h := range c.hooks {
+		if h.OnRetry != nil {
+			h.OnRetry(req, attempt)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package irys
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Ja7ad/irys/types"
+	"github.com/stretchr/testify/require"
+)
+
+// hookRecorder count calls of hooks by request path
+type hookRecorder struct {
+	mu       sync.Mutex
+	before   map[string]int
+	after    map[string]int
+	attempts map[string][]int
+	status   map[string]int
+}
+
+func newHookRecorder() *hookRecorder {
+	return &hookRecorder{
+		before:   make(map[string]int),
+		after:    make(map[string]int),
+		attempts: make(map[string][]int),
+		status:   make(map[string]int),
+	}
+}
+
+func (r *hookRecorder) hooks() Hooks {
+	return Hooks{
+		BeforeSend: func(req *http.Request) error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.before[req.URL.Path]++
+			req.Header.Set("X-Test", "hook")
+			return nil
+		},
+		AfterReceive: func(req *http.Request, resp *http.Response, err error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.after[req.URL.Path]++
+			if resp != nil {
+				r.status[req.URL.Path] = resp.StatusCode
+			}
+		},
+		OnRetry: func(req *http.Request, attempt int) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.attempts[req.URL.Path] = append(r.attempts[req.URL.Path], attempt)
+		},
+	}
+}
+
+// flaky respond 503 to first failures requests of handler, request must have header of hook
+func flaky(failures int32, next http.HandlerFunc) http.HandlerFunc {
+	handler := flakyStatus(failures, http.StatusServiceUnavailable, next)
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Test") != "hook" {
+			http.Error(w, "missing header of hook", http.StatusBadRequest)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+func TestHooks_Retry(t *testing.T) {
+	const path = "/price/" + _test_currency + "/100"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, flaky(2, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("100"))
+	}))
+
+	rec := newHookRecorder()
+	c := newTestClient(t, mux, newFakeCurrency(t), WithHooks(rec.hooks()))
+
+	price, err := c.GetPrice(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, "100", price.String())
+
+	require.Equal(t, 1, rec.before[path])
+	require.Equal(t, 1, rec.after[path])
+	require.Equal(t, []int{1, 2}, rec.attempts[path])
+	require.Equal(t, http.StatusOK, rec.status[path])
+}
+
+func TestHooks_BeforeSendError(t *testing.T) {
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price/"+_test_currency+"/100", func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	})
+
+	errHook := stderrors.New("hook error")
+	c := newTestClient(t, mux, newFakeCurrency(t))
+	c.hooks = []Hooks{{BeforeSend: func(*http.Request) error { return errHook }}}
+
+	_, err := c.GetPrice(context.Background(), 100)
+	require.ErrorIs(t, err, errHook)
+	require.Zero(t, calls.Load())
+}
+
+func TestHooks_Chunk(t *testing.T) {
+	const (
+		uuid      = "chunk-uuid"
+		generate  = "/chunks/" + _test_currency + "/-1/-1"
+		chunkPath = "/chunks/" + _test_currency + "/" + uuid + "/0"
+		finish    = "/chunks/" + _test_currency + "/" + uuid + "/-1"
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(generate, flaky(1, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, types.ChunkResponse{ID: uuid})
+	}))
+	mux.HandleFunc(chunkPath, flaky(1, func(w http.ResponseWriter, r *http.Request) {}))
+	mux.HandleFunc(finish, flaky(1, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, types.Transaction{ID: "ExampleTxId"})
+	}))
+
+	rec := newHookRecorder()
+	c := newTestClient(t, mux, newFakeCurrency(t), WithHooks(rec.hooks()))
+	ctx := context.Background()
+
+	info, err := generateChunkID(ctx, c)
+	require.NoError(t, err)
+	require.Equal(t, uuid, info.ID)
+
+	require.NoError(t, createChunkRequest(ctx, c, types.Chunk{ID: uuid, Data: []byte("chunk")}, 0, 0))
+
+	tx, err := finishChunk(ctx, c, uuid)
+	require.NoError(t, err)
+	require.Equal(t, "ExampleTxId", tx.ID)
+
+	for _, path := range []string{generate, chunkPath, finish} {
+		require.Equal(t, 1, rec.before[path], path)
+		require.Equal(t, 1, rec.after[path], path)
+		require.Equal(t, []int{1}, rec.attempts[path], path)
+	}
+}
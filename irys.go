@@ -25,14 +25,18 @@ const (
 )
 
 type Client struct {
-	mu       *sync.Mutex
-	client   *retryablehttp.Client
-	network  Node
-	currency currency.Currency
-	contract string
-	logging  logger.Logger
-	debug    bool
-	proxy    struct {
+	mu          *sync.Mutex
+	client      *retryablehttp.Client
+	network     Node
+	currency    currency.Currency
+	contract    string
+	funding     *funder
+	maxItemSize int
+	hooks       []Hooks
+	limits      map[Endpoint]RateLimit
+	logging     logger.Logger
+	debug       bool
+	proxy       struct {
 		proxyType string
 		uri       string
 		auth      proxy.Auth
@@ -42,8 +46,15 @@ type Client struct {
 type Irys interface {
 	// GetPrice return fee base on fileSize in byte for selected currency
 	GetPrice(ctx context.Context, fileSize int) (*big.Int, error)
-
-	// BasicUpload file with calculate price and topUp balance base on price (this is slower for upload)
+	// GetPriceDecimal return fee base on fileSize in byte formatted with currency symbol (e.g. "0.001 MATIC")
+	GetPriceDecimal(ctx context.Context, fileSize int) (string, error)
+	// EstimateUploadCost return price of upload data with dataSize byte and tags, price is calculated base on
+	// size of signed data item (signature, owner, tags and anchor) for client signer
+	EstimateUploadCost(ctx context.Context, dataSize int, tags []types.Tag, opts ...QuoteOption) (types.Quote, error)
+	// EstimateUploadCosts return quote of each data size for each currency (default is client currency)
+	EstimateUploadCosts(ctx context.Context, dataSizes []int, tags []types.Tag, opts ...QuoteOption) ([]types.Quote, error)
+
+	// BasicUpload file with calculate price and topUp balance base on funding policy (this is slower for upload)
 	BasicUpload(ctx context.Context, file []byte, tags ...types.Tag) (types.Transaction, error)
 	// Upload file with check balance
 	Upload(ctx context.Context, file []byte, tags ...types.Tag) (types.Transaction, error)
@@ -61,13 +72,21 @@ type Irys interface {
 
 	// GetBalance return current balance in irys node
 	GetBalance(ctx context.Context) (*big.Int, error)
-	// TopUpBalance top up your balance base on your amount in selected node
+	// GetBalanceDecimal return current balance in irys node formatted with currency symbol (e.g. "0.5 MATIC")
+	GetBalanceDecimal(ctx context.Context) (string, error)
+	// TopUpBalance top up your balance base on your amount in selected node, it return after funding transaction
+	// is confirmed and sent to node
 	TopUpBalance(ctx context.Context, amount *big.Int) error
+	// TopUpBalanceDecimal top up your balance base on decimal amount with optional symbol (e.g. "0.5 MATIC")
+	TopUpBalanceDecimal(ctx context.Context, amount string) error
+	// SpeedUpPendingTx rebroadcast top-up transactions which are not mined yet with higher gas price
+	// and return hash of replacement transactions
+	SpeedUpPendingTx(ctx context.Context) ([]string, error)
 
 	// GetReceipt get receipt information from node
 	GetReceipt(ctx context.Context, txId string) (types.Receipt, error)
 
-	// Close stop irys client request
+	// Close stop irys client request and zero private key of currency
 	Close()
 }
 
@@ -85,6 +104,7 @@ func New(node Node, currency currency.Currency, debug bool, options ...Option) (
 	irys.network = node
 	irys.currency = currency
 	irys.mu = new(sync.Mutex)
+	irys.funding = newFunder(FundingPolicy{})
 
 	irys.debug = debug
 
@@ -112,11 +132,16 @@ func New(node Node, currency currency.Currency, debug bool, options ...Option) (
 	}
 
 	irys.client.Logger = irys.logging
+	irys.client.RequestLogHook = irys.retryHook
 
 	if !debug {
 		irys.client.Logger = nil
 	}
 
+	// copy http client, so client of WithCustomClient isn't changed by transport of proxy or rate limit
+	hc := *irys.client.HTTPClient
+	irys.client.HTTPClient = &hc
+
 	if irys.client.HTTPClient.Transport == nil {
 		transport := http.DefaultTransport.(*http.Transport).Clone()
 		switch irys.proxy.proxyType {
@@ -140,12 +165,24 @@ func New(node Node, currency currency.Currency, debug bool, options ...Option) (
 		irys.client.HTTPClient.Transport = transport
 	}
 
+	if len(irys.limits) != 0 {
+		limiters := make(map[Endpoint]*limiter, len(irys.limits))
+		for class, limit := range irys.limits {
+			limiters[class] = newLimiter(limit)
+		}
+		irys.client.HTTPClient.Transport = &limitedTransport{
+			next:     irys.client.HTTPClient.Transport,
+			limiters: limiters,
+		}
+	}
+
 	irys.mu.Lock()
+	defer irys.mu.Unlock()
+
 	contract, err := irys.getNodeContract(node, currency)
 	if err != nil {
 		return nil, err
 	}
-	irys.mu.Unlock()
 
 	irys.contract = contract
 
@@ -159,19 +196,15 @@ func (c *Client) Close() {
 	if tr, ok := c.client.HTTPClient.Transport.(closeIdler); ok {
 		tr.CloseIdleConnections()
 	}
-}
-
-func (c *Client) getNodeContract(node Node, currency currency.Currency) (string, error) {
-	r, err := c.client.Get(string(node))
-	if err != nil {
-		return "", err
-	}
 
-	if err := statusCheck(r); err != nil {
-		return "", err
+	// currencies holding keys in memory (e.g. evm) zero them on close
+	if closer, ok := c.currency.(io.Closer); ok {
+		_ = closer.Close()
 	}
+}
 
-	resp, err := decodeBody[types.NodeInfo](r.Body)
+func (c *Client) getNodeContract(node Node, currency currency.Currency) (string, error) {
+	resp, err := sendJSON[types.NodeInfo](context.Background(), c, request{class: EndpointPrice, method: http.MethodGet, url: string(node)})
 	if err != nil {
 		return "", err
 	}
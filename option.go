@@ -45,6 +45,63 @@ func WithCustomLogging(logging logger.Logger) Option {
 	}
 }
 
+// WithFundingPolicy set funding policy used by BasicUpload for top up balance
+//
+// Example:
+//
+//	c, err := irys.New(irys.DefaultNode1, matic, true, irys.WithFundingPolicy(irys.FundingPolicy{
+//		LowWater:    big.NewInt(1e15),
+//		HighWater:   big.NewInt(1e16),
+//		BatchWindow: 2 * time.Second,
+//	}))
+func WithFundingPolicy(policy FundingPolicy) Option {
+	return func(irys *Client) {
+		irys.funding = newFunder(policy)
+	}
+}
+
+// WithMaxItemSize set max size in byte of signed data item accepted by node, uploads larger than
+// size fail with *types.ValidationError before signing and funding, zero is unlimited (default)
+func WithMaxItemSize(size int) Option {
+	return func(irys *Client) {
+		irys.maxItemSize = size
+	}
+}
+
+// WithHooks add hooks called for every request of client, hooks of multiple calls are called in order
+//
+// Example:
+//
+//	c, err := irys.New(irys.DefaultNode1, matic, true, irys.WithHooks(irys.Hooks{
+//		BeforeSend: func(req *http.Request) error {
+//			req.Header.Set("Authorization", "Bearer ExampleToken")
+//			return nil
+//		},
+//	}))
+func WithHooks(hooks Hooks) Option {
+	return func(irys *Client) {
+		irys.hooks = append(irys.hooks, hooks)
+	}
+}
+
+// WithRateLimit limit requests of endpoint class to node, limit is shared by all goroutines using client and
+// each retry wait for limit too. Retry-After header of rate limited response pause all requests of class.
+//
+// Example:
+//
+//	c, err := irys.New(irys.DefaultNode1, matic, true,
+//		irys.WithRateLimit(irys.EndpointUpload, irys.RateLimit{Rate: 10, Burst: 5, MaxInFlight: 4}),
+//		irys.WithRateLimit(irys.EndpointChunk, irys.RateLimit{MaxInFlight: 8}),
+//	)
+func WithRateLimit(class Endpoint, limit RateLimit) Option {
+	return func(irys *Client) {
+		if irys.limits == nil {
+			irys.limits = make(map[Endpoint]RateLimit)
+		}
+		irys.limits[class] = limit
+	}
+}
+
 // WithHttpProxy add http proxy for client
 //
 // Example:
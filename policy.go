@@ -0,0 +1,286 @@
+package irys
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/Ja7ad/irys/errors"
+)
+
+const (
+	_defaultFundingTimeout = 5 * time.Minute
+	_defaultSpendPeriod    = 24 * time.Hour
+	_defaultPollInterval   = time.Second
+)
+
+// FundingPolicy control how client top up balance for uploads.
+//
+// Uploads register their price on the policy and wait, needs registered while a top up is
+// in progress or in BatchWindow are funded with one transaction. waiting uploads are released after
+// node credit top up, and their needs are kept out of balance until uploads are done.
+type FundingPolicy struct {
+	// LowWater minimum balance must be left after pending uploads, balance is top up when it fall below it
+	LowWater *big.Int
+	// HighWater balance left after pending uploads when top up happen, lower than LowWater means LowWater
+	HighWater *big.Int
+	// MaxSpend maximum amount of top up in each Period, nil is unlimited
+	MaxSpend *big.Int
+	// Period window of MaxSpend, default is 24 hours
+	Period time.Duration
+	// BatchWindow time to wait for collect upload needs before check balance
+	BatchWindow time.Duration
+	// Approve called before each top up with amount, returned error reject top up and fail waiting uploads
+	Approve func(ctx context.Context, amount *big.Int) error
+	// Timeout of each balance check and top up, default is 5 minutes
+	Timeout time.Duration
+}
+
+type spend struct {
+	at     time.Time
+	amount *big.Int
+}
+
+// fundWaiter is upload waiting for funding, its need is committed after funding until upload is done
+type fundWaiter struct {
+	need      *big.Int
+	done      chan error
+	committed bool
+}
+
+type funder struct {
+	mu      sync.Mutex
+	policy  FundingPolicy
+	running bool
+	need    *big.Int
+	waiters []*fundWaiter
+	spends  []spend
+	// committed is need of funded uploads which are not done yet, node may not charge them yet
+	committed    *big.Int
+	pollInterval time.Duration
+}
+
+func newFunder(policy FundingPolicy) *funder {
+	if policy.LowWater == nil {
+		policy.LowWater = new(big.Int)
+	}
+	if policy.HighWater == nil || policy.HighWater.Cmp(policy.LowWater) < 0 {
+		policy.HighWater = policy.LowWater
+	}
+	if policy.Timeout == 0 {
+		policy.Timeout = _defaultFundingTimeout
+	}
+	if policy.Period <= 0 {
+		policy.Period = _defaultSpendPeriod
+	}
+
+	return &funder{
+		policy:       policy,
+		need:         new(big.Int),
+		committed:    new(big.Int),
+		pollInterval: _defaultPollInterval,
+	}
+}
+
+// ensureBalance block until balance cover need base on funding policy, returned done must be called
+// when upload is finished or failed. if ctx is done before funding start, need is removed from the batch.
+func (c *Client) ensureBalance(ctx context.Context, need *big.Int) (done func(), err error) {
+	f := c.funding
+	w := &fundWaiter{need: need, done: make(chan error, 1)}
+
+	f.mu.Lock()
+	f.need.Add(f.need, need)
+	f.waiters = append(f.waiters, w)
+	if !f.running {
+		f.running = true
+		go c.runFunding()
+	}
+	f.mu.Unlock()
+
+	select {
+	case err := <-w.done:
+		if err != nil {
+			return nil, err
+		}
+		return func() { f.uncommit(w) }, nil
+	case <-ctx.Done():
+		f.cancel(w)
+		return nil, ctx.Err()
+	}
+}
+
+// cancel remove waiter from the batch, or uncommit its need when it's already funded
+func (f *funder) cancel(w *fundWaiter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, waiter := range f.waiters {
+		if waiter == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			f.need.Sub(f.need, w.need)
+			return
+		}
+	}
+
+	if w.committed {
+		w.committed = false
+		f.committed.Sub(f.committed, w.need)
+	}
+}
+
+// uncommit remove need of done upload from committed
+func (f *funder) uncommit(w *fundWaiter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if w.committed {
+		w.committed = false
+		f.committed.Sub(f.committed, w.need)
+	}
+}
+
+func (c *Client) runFunding() {
+	f := c.funding
+	for {
+		if f.policy.BatchWindow > 0 {
+			time.Sleep(f.policy.BatchWindow)
+		}
+
+		f.mu.Lock()
+		need, waiters := f.need, f.waiters
+		if len(waiters) == 0 {
+			f.running = false
+			f.mu.Unlock()
+			return
+		}
+		f.need, f.waiters = new(big.Int), nil
+		f.mu.Unlock()
+
+		c.debugMsg("[Funding] check balance for %d uploads need %s", len(waiters), need.String())
+		err := c.fund(need)
+
+		f.mu.Lock()
+		for _, w := range waiters {
+			if err == nil {
+				w.committed = true
+				f.committed.Add(f.committed, w.need)
+			}
+			w.done <- err
+		}
+		f.mu.Unlock()
+	}
+}
+
+// available return balance which isn't committed to uploads
+func (f *funder) available(balance *big.Int) *big.Int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return new(big.Int).Sub(balance, f.committed)
+}
+
+func (c *Client) fund(need *big.Int) error {
+	f := c.funding
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.policy.Timeout)
+	defer cancel()
+
+	balance, err := c.GetBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	left := new(big.Int).Sub(f.available(balance), need)
+	if left.Cmp(f.policy.LowWater) >= 0 {
+		return nil
+	}
+
+	amount := new(big.Int).Sub(f.policy.HighWater, left)
+
+	if err := f.reserve(amount); err != nil {
+		return err
+	}
+
+	if f.policy.Approve != nil {
+		if err := f.policy.Approve(ctx, amount); err != nil {
+			f.release(amount)
+			return err
+		}
+	}
+
+	if err := c.TopUpBalance(ctx, amount); err != nil {
+		f.release(amount)
+		return err
+	}
+	c.debugMsg("[Funding] topUp balance %s", amount.String())
+
+	return c.waitForCredit(ctx, need)
+}
+
+// waitForCredit poll balance until node credit top up and balance cover need
+func (c *Client) waitForCredit(ctx context.Context, need *big.Int) error {
+	f := c.funding
+
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		balance, err := c.GetBalance(ctx)
+		if err != nil {
+			return err
+		}
+
+		left := new(big.Int).Sub(f.available(balance), need)
+		if left.Cmp(f.policy.LowWater) >= 0 {
+			return nil
+		}
+		c.debugMsg("[Funding] wait for node to credit top up, balance %s", balance.String())
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve record amount as spent in current period, return error if it exceed max spend
+func (f *funder) reserve(amount *big.Int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.policy.MaxSpend == nil {
+		return nil
+	}
+
+	now := time.Now()
+	spent := new(big.Int).Set(amount)
+	spends := f.spends[:0]
+	for _, s := range f.spends {
+		if now.Sub(s.at) < f.policy.Period {
+			spends = append(spends, s)
+			spent.Add(spent, s.amount)
+		}
+	}
+	f.spends = spends
+
+	if spent.Cmp(f.policy.MaxSpend) > 0 {
+		return errors.ErrFundingLimitExceeded
+	}
+
+	f.spends = append(f.spends, spend{at: now, amount: amount})
+	return nil
+}
+
+// release remove reserved amount of failed top up
+func (f *funder) release(amount *big.Int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := len(f.spends) - 1; i >= 0; i-- {
+		if f.spends[i].amount == amount {
+			f.spends = append(f.spends[:i], f.spends[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package irys
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunder_Reserve(t *testing.T) {
+	f := newFunder(FundingPolicy{MaxSpend: big.NewInt(10)})
+	require.Equal(t, _defaultSpendPeriod, f.policy.Period)
+
+	require.NoError(t, f.reserve(big.NewInt(10)))
+	require.ErrorIs(t, f.reserve(big.NewInt(10)), errors.ErrFundingLimitExceeded)
+
+	// spends out of period are dropped
+	f = newFunder(FundingPolicy{MaxSpend: big.NewInt(10), Period: 50 * time.Millisecond})
+	require.NoError(t, f.reserve(big.NewInt(6)))
+	require.ErrorIs(t, f.reserve(big.NewInt(6)), errors.ErrFundingLimitExceeded)
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, f.reserve(big.NewInt(6)))
+
+	// released amount of failed top up isn't counted
+	amount := big.NewInt(4)
+	require.NoError(t, f.reserve(amount))
+	f.release(amount)
+	require.NoError(t, f.reserve(big.NewInt(4)))
+}
+
+// fundingNode is node which credit top up of cur when tx is posted, credit is delayed by
+// creditDelay balance requests
+type fundingNode struct {
+	cur         *fakeCurrency
+	creditDelay int
+
+	mu      sync.Mutex
+	balance *big.Int
+	topUps  int
+	pending []*big.Int
+	delay   int
+}
+
+func newFundingNode(cur *fakeCurrency, creditDelay int) *fundingNode {
+	return &fundingNode{cur: cur, creditDelay: creditDelay, balance: new(big.Int)}
+}
+
+func (n *fundingNode) mux(t *testing.T) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/account/balance/"+_test_currency, func(w http.ResponseWriter, r *http.Request) {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		if r.Method == http.MethodPost {
+			var req types.TxToBalanceRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+			var index int
+			_, err := fmt.Sscanf(req.TxId, "tx-%d", &index)
+			require.NoError(t, err)
+
+			n.topUps++
+			n.pending = append(n.pending, n.cur.sent()[index-1])
+			n.delay = n.creditDelay
+			return
+		}
+
+		if n.delay > 0 {
+			n.delay--
+		} else {
+			for _, amount := range n.pending {
+				n.balance.Add(n.balance, amount)
+			}
+			n.pending = nil
+		}
+		writeJSON(w, types.BalanceResponse{Balance: n.balance.String()})
+	})
+	return mux
+}
+
+// ensureBalances call ensureBalance concurrently and return errors and done funcs of calls
+func ensureBalances(c *Client, needs ...int64) ([]error, []func()) {
+	var wg sync.WaitGroup
+	errs := make([]error, len(needs))
+	dones := make([]func(), len(needs))
+	for i, need := range needs {
+		wg.Add(1)
+		go func(i int, need int64) {
+			defer wg.Done()
+			dones[i], errs[i] = c.ensureBalance(context.Background(), big.NewInt(need))
+		}(i, need)
+	}
+	wg.Wait()
+	return errs, dones
+}
+
+func TestEnsureBalance_Batch(t *testing.T) {
+	cur := newFakeCurrency(t)
+	node := newFundingNode(cur, 0)
+	c := newTestClient(t, node.mux(t), cur, WithFundingPolicy(FundingPolicy{
+		BatchWindow: 100 * time.Millisecond,
+	}))
+
+	needs := make([]int64, 10)
+	for i := range needs {
+		needs[i] = 3
+	}
+	errs, _ := ensureBalances(c, needs...)
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	sent := cur.sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, big.NewInt(3*int64(len(needs))), sent[0])
+	require.Equal(t, 1, node.topUps)
+}
+
+func TestEnsureBalance_ApproveError(t *testing.T) {
+	denied := stderrors.New("denied")
+	cur := newFakeCurrency(t)
+	node := newFundingNode(cur, 0)
+	c := newTestClient(t, node.mux(t), cur, WithFundingPolicy(FundingPolicy{
+		BatchWindow: 100 * time.Millisecond,
+		Approve: func(_ context.Context, _ *big.Int) error {
+			return denied
+		},
+	}))
+
+	errs, _ := ensureBalances(c, 1, 1, 1, 1, 1)
+	for _, err := range errs {
+		require.ErrorIs(t, err, denied)
+	}
+
+	require.Empty(t, cur.sent())
+	require.Zero(t, node.topUps)
+}
+
+func TestEnsureBalance_Committed(t *testing.T) {
+	cur := newFakeCurrency(t)
+	node := newFundingNode(cur, 0)
+	c := newTestClient(t, node.mux(t), cur, WithFundingPolicy(FundingPolicy{}))
+
+	doneA, err := c.ensureBalance(context.Background(), big.NewInt(3))
+	require.NoError(t, err)
+
+	// balance of first top up is committed to upload which isn't done
+	doneB, err := c.ensureBalance(context.Background(), big.NewInt(3))
+	require.NoError(t, err)
+	require.Equal(t, []*big.Int{big.NewInt(3), big.NewInt(3)}, cur.sent())
+
+	doneA()
+	doneA()
+	doneB()
+
+	// node didn't charge uploads, so balance cover next upload
+	doneC, err := c.ensureBalance(context.Background(), big.NewInt(6))
+	require.NoError(t, err)
+	doneC()
+	require.Len(t, cur.sent(), 2)
+}
+
+func TestEnsureBalance_WaitForCredit(t *testing.T) {
+	cur := newFakeCurrency(t)
+	node := newFundingNode(cur, 3)
+	c := newTestClient(t, node.mux(t), cur, WithFundingPolicy(FundingPolicy{}))
+	c.funding.pollInterval = time.Millisecond
+
+	done, err := c.ensureBalance(context.Background(), big.NewInt(5))
+	require.NoError(t, err)
+	done()
+
+	// upload is released after node credit top up
+	node.mu.Lock()
+	require.Equal(t, big.NewInt(5), node.balance)
+	require.Zero(t, node.delay)
+	node.mu.Unlock()
+	require.Len(t, cur.sent(), 1)
+}
+
+func TestEnsureBalance_Cancel(t *testing.T) {
+	cur := newFakeCurrency(t)
+	node := newFundingNode(cur, 0)
+	c := newTestClient(t, node.mux(t), cur, WithFundingPolicy(FundingPolicy{
+		BatchWindow: 200 * time.Millisecond,
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var (
+		wg  sync.WaitGroup
+		err error
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err = c.ensureBalance(ctx, big.NewInt(100))
+	}()
+
+	done, errB := c.ensureBalance(context.Background(), big.NewInt(1))
+	wg.Wait()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NoError(t, errB)
+	done()
+
+	// need of cancelled upload isn't funded
+	require.Equal(t, []*big.Int{big.NewInt(1)}, cur.sent())
+}
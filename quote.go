@@ -0,0 +1,128 @@
+package irys
+
+import (
+	"context"
+
+	"github.com/Ja7ad/irys/currency"
+	"github.com/Ja7ad/irys/signer"
+	"github.com/Ja7ad/irys/types"
+	"github.com/Ja7ad/irys/verifier"
+)
+
+const _defaultQuoteContentType = "application/octet-stream"
+
+type quoteOptions struct {
+	currencies  []string
+	contentType string
+	noAnchor    bool
+}
+
+type QuoteOption func(opts *quoteOptions)
+
+// WithQuoteCurrencies quote price in currencies by irys name instead of client currency
+func WithQuoteCurrencies(names ...string) QuoteOption {
+	return func(opts *quoteOptions) {
+		opts.currencies = names
+	}
+}
+
+// WithQuoteContentType set Content-Type tag used when tags doesn't include it,
+// upload detect it from data, default is application/octet-stream
+func WithQuoteContentType(contentType string) QuoteOption {
+	return func(opts *quoteOptions) {
+		opts.contentType = contentType
+	}
+}
+
+// WithQuoteNoAnchor calculate data item size without anchor
+func WithQuoteNoAnchor() QuoteOption {
+	return func(opts *quoteOptions) {
+		opts.noAnchor = true
+	}
+}
+
+func (c *Client) EstimateUploadCost(ctx context.Context, dataSize int, tags []types.Tag, opts ...QuoteOption) (types.Quote, error) {
+	quotes, err := c.EstimateUploadCosts(ctx, []int{dataSize}, tags, opts...)
+	if err != nil {
+		return types.Quote{}, err
+	}
+	return quotes[0], nil
+}
+
+func (c *Client) EstimateUploadCosts(ctx context.Context, dataSizes []int, tags []types.Tag, opts ...QuoteOption) ([]types.Quote, error) {
+	options := &quoteOptions{
+		currencies:  []string{c.currency.GetName()},
+		contentType: _defaultQuoteContentType,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	quotes := make([]types.Quote, 0, len(dataSizes)*len(options.currencies))
+	for _, name := range options.currencies {
+		info, err := c.currencyInfo(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dataSize := range dataSizes {
+			itemSize, err := dataItemSize(info.SignatureType, dataSize, options, tags...)
+			if err != nil {
+				return nil, err
+			}
+
+			price, err := c.getPrice(ctx, name, itemSize)
+			if err != nil {
+				return nil, err
+			}
+
+			quotes = append(quotes, types.Quote{
+				Currency: name,
+				DataSize: dataSize,
+				ItemSize: itemSize,
+				Atomic:   price,
+				Decimal:  currency.FormatUnits(price, info.Decimals),
+			})
+		}
+	}
+
+	return quotes, nil
+}
+
+// currencyInfo return metadata of currency, client currency signer and decimals are preferred
+func (c *Client) currencyInfo(name string) (currency.Info, error) {
+	info, err := currency.GetInfo(name)
+	if name != c.currency.GetName() {
+		return info, err
+	}
+	if err != nil {
+		info = currency.Info{Name: name, Symbol: c.currency.GetSymbol()}
+	}
+	info.Decimals = c.currency.Decimals()
+	info.SignatureType = c.currency.GetSinger().GetType()
+	return info, nil
+}
+
+// dataItemSize calculate size of signed data item same as upload create it
+func dataItemSize(signatureType signer.SignatureType, dataSize int, opts *quoteOptions, tags ...types.Tag) (int, error) {
+	if _, err := verifier.GetConfig(signatureType); err != nil {
+		return 0, err
+	}
+
+	dataItem := types.BundleItem{
+		SignatureType: signatureType,
+		Tags:          addContentType(opts.contentType, tags...),
+	}
+
+	if !opts.noAnchor {
+		dataItem.Anchor = make([]byte, 32)
+	}
+
+	size := dataItem.Size()
+	if size < 0 {
+		_, err := dataItem.Tags.Marshal()
+		return 0, err
+	}
+
+	return size + dataSize, nil
+}
@@ -0,0 +1,124 @@
+package irys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Ja7ad/irys/currency"
+	"github.com/Ja7ad/irys/signer"
+	"github.com/Ja7ad/irys/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testSigners(t *testing.T) []signer.Signer {
+	key, err := rsa.GenerateKey(rand.Reader, 4096)
+	require.NoError(t, err)
+	arweave, err := signer.NewArweaveSignerFromPEM(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+	require.NoError(t, err)
+
+	eth, err := signer.NewEthereumSigner("0x" + _test_evm_private_key)
+	require.NoError(t, err)
+	typed, err := signer.NewTypedEthereumSigner("0x" + _test_evm_private_key)
+	require.NoError(t, err)
+	sol, err := signer.NewSolanaSigner("2Ana1pUpv2ZbMVkwF5FXapYeBEjdxDatLn7nvJkhgTSdZd8hbDHTd21as7EAsg7ypityqfsw2pMQKJcVDVcAEsd")
+	require.NoError(t, err)
+	aptos, err := signer.NewAptosSigner("0x0b1fd9bd37ab4ad03f6fe5c17a1b1ad0e3a7e8a8a2ad34b2bd5bb2a1cc1e7d3f")
+	require.NoError(t, err)
+
+	return []signer.Signer{arweave, eth, typed, sol, aptos}
+}
+
+func TestDataItemSize(t *testing.T) {
+	data := []byte("hello irys")
+	tags := []types.Tag{{Name: "App-Name", Value: "irys-go"}, {Name: "Topic", Value: strings.Repeat("a", 100)}}
+
+	for _, s := range testSigners(t) {
+		for _, withTags := range []bool{false, true} {
+			for _, withAnchor := range []bool{false, true} {
+				name := fmt.Sprintf("type %d tags %v anchor %v", s.GetType(), withTags, withAnchor)
+				t.Run(name, func(t *testing.T) {
+					var itemTags []types.Tag
+					if withTags {
+						itemTags = tags
+					}
+
+					signed, err := signFile(data, s, withAnchor, itemTags...)
+					require.NoError(t, err)
+
+					size, err := dataItemSize(s.GetType(), len(data), &quoteOptions{
+						contentType: http.DetectContentType(data),
+						noAnchor:    !withAnchor,
+					}, itemTags...)
+					require.NoError(t, err)
+					require.Equal(t, len(signed), size)
+				})
+			}
+		}
+	}
+}
+
+func TestEstimateUploadCosts(t *testing.T) {
+	mux := http.NewServeMux()
+	// price is 2 atomic unit per byte
+	mux.HandleFunc("/price/"+_test_currency+"/", func(w http.ResponseWriter, r *http.Request) {
+		var size int
+		_, err := fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/price/"+_test_currency+"/"), "%d", &size)
+		require.NoError(t, err)
+		_, _ = fmt.Fprintf(w, "%d", size*2)
+	})
+	cur := newFakeCurrency(t)
+	c := newTestClient(t, mux, cur)
+
+	tags := []types.Tag{{Name: "App-Name", Value: "irys-go"}}
+	quotes, err := c.EstimateUploadCosts(context.Background(), []int{0, 1000}, tags)
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+
+	for i, dataSize := range []int{0, 1000} {
+		itemSize, err := dataItemSize(cur.signer.GetType(), dataSize, &quoteOptions{contentType: _defaultQuoteContentType}, tags...)
+		require.NoError(t, err)
+
+		require.Equal(t, _test_currency, quotes[i].Currency)
+		require.Equal(t, dataSize, quotes[i].DataSize)
+		require.Equal(t, itemSize, quotes[i].ItemSize)
+		require.Equal(t, int64(itemSize*2), quotes[i].Atomic.Int64())
+		require.Equal(t, currency.FormatUnits(quotes[i].Atomic, cur.Decimals()), quotes[i].Decimal)
+	}
+}
+
+func TestBasicUpload_PriceItemSize(t *testing.T) {
+	var priced int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price/"+_test_currency+"/", func(w http.ResponseWriter, r *http.Request) {
+		_, err := fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/price/"+_test_currency+"/"), "%d", &priced)
+		require.NoError(t, err)
+		_, _ = fmt.Fprint(w, "1")
+	})
+	mux.HandleFunc("/account/balance/"+_test_currency, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, types.BalanceResponse{Balance: "100"})
+	})
+	var uploaded int
+	mux.HandleFunc("/tx/"+_test_currency, func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		uploaded = len(b)
+		writeJSON(w, types.Transaction{ID: "id"})
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t))
+
+	tx, err := c.BasicUpload(context.Background(), []byte("hello irys"), types.Tag{Name: "App-Name", Value: "irys-go"})
+	require.NoError(t, err)
+	require.Equal(t, "id", tx.ID)
+	require.Equal(t, uploaded, priced)
+}
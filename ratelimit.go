@@ -0,0 +1,192 @@
+package irys
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Endpoint is class of node endpoints which share rate limit
+type Endpoint int
+
+const (
+	// EndpointPrice is price, balance and node info requests
+	EndpointPrice Endpoint = iota + 1
+	// EndpointUpload is upload and top up requests
+	EndpointUpload
+	// EndpointChunk is chunk upload requests
+	EndpointChunk
+	// EndpointGraphql is graphql requests (e.g. receipt)
+	EndpointGraphql
+)
+
+// RateLimit of endpoint class, zero values are unlimited
+type RateLimit struct {
+	// Rate max requests per second
+	Rate float64
+	// Burst max requests sent at once before Rate is applied, default is 1
+	Burst int
+	// MaxInFlight max concurrent requests
+	MaxInFlight int
+}
+
+type endpointKey struct{}
+
+func withEndpoint(ctx context.Context, class Endpoint) context.Context {
+	return context.WithValue(ctx, endpointKey{}, class)
+}
+
+// limiter limit requests of one endpoint class, it's shared by all goroutines of client
+type limiter struct {
+	rate     *rate.Limiter
+	inFlight chan struct{}
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func newLimiter(limit RateLimit) *limiter {
+	l := new(limiter)
+	if limit.Rate > 0 {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		l.rate = rate.NewLimiter(rate.Limit(limit.Rate), burst)
+	}
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// acquire wait for pause, rate and in flight limit, release must be called when request is done
+func (l *limiter) acquire(ctx context.Context) (release func(), err error) {
+	if err := l.waitPause(ctx); err != nil {
+		return nil, err
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-l.inFlight })
+	}, nil
+}
+
+func (l *limiter) waitPause(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		wait := time.Until(l.pausedUntil)
+		l.mu.Unlock()
+
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// pause stop sending requests until time
+func (l *limiter) pause(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// limitedTransport apply limiter of endpoint class to each attempt of request, Retry-After of
+// rate limited response pause the class for all requests
+type limitedTransport struct {
+	next     http.RoundTripper
+	limiters map[Endpoint]*limiter
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	class, _ := req.Context().Value(endpointKey{}).(Endpoint)
+	l, ok := t.limiters[class]
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	release, err := l.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if until, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			l.pause(until)
+		}
+	}
+
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+func (t *limitedTransport) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+	if tr, ok := t.next.(closeIdler); ok {
+		tr.CloseIdleConnections()
+	}
+}
+
+// releaseBody release in flight slot of request when body is closed
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+// retryAfter parse Retry-After header in seconds or http date
+func retryAfter(value string) (time.Time, bool) {
+	if len(value) == 0 {
+		return time.Time{}, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Now().Add(time.Duration(seconds) * time.Second), true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return at, true
+	}
+	return time.Time{}, false
+}
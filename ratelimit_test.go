@@ -0,0 +1,194 @@
+package irys
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimit_MaxInFlight(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price/"+_test_currency+"/100", func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			max := maxInFlight.Load()
+			if n <= max || maxInFlight.CompareAndSwap(max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte("100"))
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t), WithRateLimit(EndpointPrice, RateLimit{MaxInFlight: 2}))
+
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = c.GetPrice(context.Background(), 100)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestRateLimit_Rate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price/"+_test_currency+"/100", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("100"))
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t), WithRateLimit(EndpointPrice, RateLimit{Rate: 20}))
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := c.GetPrice(context.Background(), 100)
+		require.NoError(t, err)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+}
+
+func TestRateLimit_RetryAfterPause(t *testing.T) {
+	var calls atomic.Int32
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+		if calls.Add(1) == 1 {
+			resp.StatusCode = http.StatusTooManyRequests
+			resp.Header.Set("Retry-After", "1")
+		}
+		return resp, nil
+	})
+	tr := &limitedTransport{next: next, limiters: map[Endpoint]*limiter{
+		EndpointPrice:  newLimiter(RateLimit{}),
+		EndpointUpload: newLimiter(RateLimit{}),
+	}}
+
+	do := func(ctx context.Context, class Endpoint) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(withEndpoint(ctx, class), http.MethodGet, "http://node/price", nil)
+		require.NoError(t, err)
+		return tr.RoundTrip(req)
+	}
+
+	resp, err := do(context.Background(), EndpointPrice)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	// other class is not paused
+	start := time.Now()
+	resp, err = do(context.Background(), EndpointUpload)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// paused request is cancelled by ctx
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = do(ctx, EndpointPrice)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, int32(2), calls.Load())
+
+	resp, err = do(context.Background(), EndpointPrice)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestRateLimit_ReleaseOnRetry(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price/"+_test_currency+"/100", flakyStatus(2, http.StatusServiceUnavailable, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("100"))
+	}))
+	c := newTestClient(t, mux, newFakeCurrency(t), WithRateLimit(EndpointPrice, RateLimit{MaxInFlight: 1}))
+
+	// retry must not wait for slot of its previous attempt
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	price, err := c.GetPrice(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, "100", price.String())
+
+	_, err = c.GetPrice(ctx, 100)
+	require.NoError(t, err)
+}
+
+func TestRateLimit_ReleaseOnStatusError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price/"+_test_currency+"/100", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t), WithRateLimit(EndpointPrice, RateLimit{MaxInFlight: 1}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		_, err := c.GetPrice(ctx, 100)
+		var httpErr *errors.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		require.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	until, ok := retryAfter("2")
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(2*time.Second), until, time.Second)
+
+	at := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	until, ok = retryAfter(at.Format(http.TimeFormat))
+	require.True(t, ok)
+	require.Equal(t, at, until.UTC())
+
+	for _, value := range []string{"", "-1", "soon"} {
+		_, ok = retryAfter(value)
+		require.False(t, ok, value)
+	}
+}
+
+func TestRateLimit_CustomClient(t *testing.T) {
+	custom := &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}
+	transport := custom.Transport
+
+	for i := 0; i < 2; i++ {
+		c := newTestClient(t, http.NewServeMux(), newFakeCurrency(t),
+			WithCustomClient(custom),
+			WithRateLimit(EndpointPrice, RateLimit{MaxInFlight: 1}),
+		)
+
+		// each client wrap transport of custom client once
+		limited, ok := c.client.HTTPClient.Transport.(*limitedTransport)
+		require.True(t, ok)
+		require.Equal(t, transport, limited.next)
+		require.NotSame(t, custom, c.client.HTTPClient)
+	}
+
+	require.Equal(t, transport, custom.Transport)
+}
@@ -0,0 +1,155 @@
+package irys
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// _maxResponseSize is max size of json response body read from node
+const _maxResponseSize = 10 << 20
+
+// request is http request sent to node or gateway by send
+type request struct {
+	class       Endpoint
+	method      string
+	url         string
+	body        []byte
+	contentType string
+	header      map[string]string
+}
+
+// send do request and check status of response, response body is closed when error is returned.
+// cancellation of request is taken from ctx, including retry backoff and reading response body
+func (c *Client) send(ctx context.Context, r request) (*http.Response, error) {
+	var body io.Reader
+	if r.body != nil {
+		body = bytes.NewReader(r.body)
+	}
+
+	if r.class != 0 {
+		ctx = withEndpoint(ctx, r.class)
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, r.method, r.url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.contentType) != 0 {
+		req.Header.Set("Content-Type", r.contentType)
+	}
+	for k, v := range r.header {
+		req.Header.Set(k, v)
+	}
+
+	if err := c.beforeSend(req.Request); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	c.afterReceive(req.Request, resp, err)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := statusCheck(resp); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// sendNoContent do request and discard response body
+func (c *Client) sendNoContent(ctx context.Context, r request) error {
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(io.Discard, io.LimitReader(resp.Body, _maxResponseSize))
+	return err
+}
+
+// sendJSON do request and decode json response body into T
+func sendJSON[T any](ctx context.Context, c *Client, r request) (T, error) {
+	var out T
+
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return out, err
+	}
+	defer resp.Body.Close()
+
+	if err := jsonContentType(resp); err != nil {
+		return out, err
+	}
+
+	b, err := readBody(resp)
+	if err != nil {
+		return out, err
+	}
+
+	return out, json.Unmarshal(b, &out)
+}
+
+// sendBigInt do request and parse response body as decimal integer, node respond price as plain number
+func (c *Client) sendBigInt(ctx context.Context, r request) (*big.Int, error) {
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	n, ok := new(big.Int).SetString(strings.Trim(strings.TrimSpace(string(b)), `"`), 10)
+	if !ok {
+		return nil, errors.ErrInvalidResponse
+	}
+
+	return n, nil
+}
+
+// readBody read response body up to _maxResponseSize
+func readBody(resp *http.Response) ([]byte, error) {
+	b, err := io.ReadAll(io.LimitReader(resp.Body, _maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > _maxResponseSize {
+		return nil, errors.ErrResponseTooLarge
+	}
+	return b, nil
+}
+
+// jsonContentType check response is json, response without Content-Type is accepted
+func jsonContentType(resp *http.Response) error {
+	contentType := resp.Header.Get("Content-Type")
+	if len(contentType) == 0 {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return errors.ErrUnexpectedContentType
+	}
+	if mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+		return errors.ErrUnexpectedContentType
+	}
+
+	return nil
+}
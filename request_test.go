@@ -0,0 +1,112 @@
+package irys
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReceipt_NotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, types.ReceiptResponse{})
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t))
+
+	_, err := c.GetReceipt(context.Background(), "ExampleTxId")
+	require.ErrorIs(t, err, errors.ErrNotFound)
+}
+
+func TestSend_ContentType(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/account/balance/"+_test_currency, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`{"balance": "1"}`))
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t))
+
+	_, err := c.GetBalance(context.Background())
+	require.ErrorIs(t, err, errors.ErrUnexpectedContentType)
+}
+
+func TestSend_ResponseTooLarge(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/account/balance/"+_test_currency, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"balance": "1"}` + strings.Repeat(" ", _maxResponseSize)))
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t))
+
+	_, err := c.GetBalance(context.Background())
+	require.ErrorIs(t, err, errors.ErrResponseTooLarge)
+}
+
+func TestGetPrice(t *testing.T) {
+	for _, body := range []string{"12345", `"12345"`, "12345\n"} {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/price/"+_test_currency+"/100", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(body))
+		})
+		c := newTestClient(t, mux, newFakeCurrency(t))
+
+		price, err := c.GetPrice(context.Background(), 100)
+		require.NoError(t, err, body)
+		require.Equal(t, "12345", price.String())
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price/"+_test_currency+"/100", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not a number"))
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t))
+
+	_, err := c.GetPrice(context.Background(), 100)
+	require.ErrorIs(t, err, errors.ErrInvalidResponse)
+}
+
+func TestSend_CancelRetryBackoff(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price/"+_test_currency+"/100", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t),
+		WithCustomRetryWaitMin(time.Minute),
+		WithCustomRetryWaitMax(time.Minute),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetPrice(ctx, 100)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestStatusCheck_HTTPError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/tx/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(_requestIDHeader, "request-1")
+		http.Error(w, "tx not found", http.StatusNotFound)
+	})
+	c := newTestClient(t, mux, newFakeCurrency(t))
+
+	_, err := sendJSON[types.Transaction](context.Background(), c, request{
+		method: http.MethodGet,
+		url:    string(c.network) + "/tx/ExampleTxId?foo=bar",
+	})
+	require.ErrorIs(t, err, errors.ErrNotFound)
+
+	var httpErr *errors.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusNotFound, httpErr.StatusCode)
+	require.Equal(t, "tx not found", httpErr.Body)
+	require.Equal(t, "request-1", httpErr.RequestID)
+	require.Equal(t, "GET "+string(c.network)+"/tx/ExampleTxId", httpErr.Endpoint)
+}
@@ -0,0 +1,60 @@
+package signer
+
+import (
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/base64"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+)
+
+const _algorand_checksum_length = 4
+
+// AlgorandSigner sign data items with generic ed25519 signature type, owner is raw public key
+type AlgorandSigner struct {
+	Ed25519Signer
+}
+
+// NewAlgorandSigner create signer from base64 algorand private key (64 bytes) or seed (32 bytes)
+func NewAlgorandSigner(privateKeyBase64 string) (self *AlgorandSigner, err error) {
+	buf, err := base64.StdEncoding.DecodeString(privateKeyBase64)
+	if err != nil {
+		return
+	}
+
+	s, err := NewEd25519Signer(buf)
+	if err != nil {
+		return
+	}
+
+	return &AlgorandSigner{Ed25519Signer: *s}, nil
+}
+
+// Address return algorand address of signer, base32 of public key with checksum
+func (self *AlgorandSigner) Address() string {
+	return AlgorandAddress(self.Owner)
+}
+
+// AlgorandAddress encode public key to algorand address, base32 (without padding) of public key
+// and last 4 bytes of sha512/256 public key as checksum
+func AlgorandAddress(publicKey []byte) string {
+	checksum := sha512.Sum512_256(publicKey)
+	b := append(append([]byte{}, publicKey...), checksum[len(checksum)-_algorand_checksum_length:]...)
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)
+}
+
+// DecodeAlgorandAddress decode algorand address to public key and validate checksum
+func DecodeAlgorandAddress(address string) ([]byte, error) {
+	b, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(address))
+	if err != nil || len(b) != 32+_algorand_checksum_length {
+		return nil, errors.ErrInvalidAddress
+	}
+
+	publicKey := b[:32]
+	if AlgorandAddress(publicKey) != strings.ToUpper(address) {
+		return nil, errors.ErrInvalidAddress
+	}
+
+	return publicKey, nil
+}
@@ -0,0 +1,53 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const ALGORAND_PRIVATE_KEY = `AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA=`
+
+func TestAlgorandSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(AlgorandSignerTestSuite))
+}
+
+type AlgorandSignerTestSuite struct {
+	suite.Suite
+}
+
+func (s *AlgorandSignerTestSuite) TestCreation() {
+	signer, err := NewAlgorandSigner(ALGORAND_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+	require.NotNil(s.T(), signer)
+	require.Equal(s.T(), ALGORAND, signer.GetType())
+
+	address := signer.Address()
+	require.Len(s.T(), address, 58)
+
+	publicKey, err := DecodeAlgorandAddress(address)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), signer.Owner, publicKey)
+
+	// zero public key is algorand zero address
+	require.Equal(s.T(), "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ", AlgorandAddress(make([]byte, 32)))
+
+	_, err = DecodeAlgorandAddress("AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKA")
+	require.NotNil(s.T(), err)
+}
+
+func (s *AlgorandSignerTestSuite) TestSignAndVerify() {
+	signer, err := NewAlgorandSigner(ALGORAND_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+
+	data := []byte("to be signed")
+
+	signature, err := signer.Sign(data)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), len(signature), signer.GetSignatureLength())
+
+	verifier, err := GetSigner(ALGORAND, signer.Owner)
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), verifier.Verify(data, signature))
+}
@@ -0,0 +1,60 @@
+package signer
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	_aptos_private_key_prefix = "ed25519-priv-"
+	// https://github.com/aptos-labs/aptos-core/blob/main/types/src/transaction/authenticator.rs
+	_aptos_single_key_scheme = 0x00
+)
+
+type AptosSigner struct {
+	Ed25519Signer
+}
+
+// NewAptosSigner create signer from hex aptos private key (with or without 0x and ed25519-priv- prefix)
+func NewAptosSigner(privateKeyHex string) (self *AptosSigner, err error) {
+	privateKeyHex = strings.TrimPrefix(privateKeyHex, _aptos_private_key_prefix)
+	privateKeyHex = strings.TrimPrefix(privateKeyHex, "0x")
+
+	buf, err := hex.DecodeString(privateKeyHex)
+	if err != nil {
+		return
+	}
+
+	s, err := NewEd25519Signer(buf)
+	if err != nil {
+		return
+	}
+
+	return &AptosSigner{Ed25519Signer: *s}, nil
+}
+
+// Sign data same as aptos wallet signMessage with bundlr nonce, which is verified by bundlr for aptos data items
+func (self *AptosSigner) Sign(data []byte) (signature []byte, err error) {
+	return self.Ed25519Signer.Sign(AptosMessage(data))
+}
+
+func (self *AptosSigner) Verify(data []byte, signature []byte) (err error) {
+	return self.Ed25519Signer.Verify(AptosMessage(data), signature)
+}
+
+// AptosMessage return full message signed by aptos wallets for data
+func AptosMessage(data []byte) []byte {
+	return []byte("APTOS\nmessage: " + hex.EncodeToString(data) + "\nnonce: bundlr")
+}
+
+// Address return aptos account address of signer (sha3-256 of public key and single key scheme)
+func (self *AptosSigner) Address() string {
+	hash := sha3.Sum256(append(append([]byte{}, self.Owner...), _aptos_single_key_scheme))
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+func (self *AptosSigner) GetType() SignatureType {
+	return APTOS
+}
@@ -0,0 +1,53 @@
+package signer
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const APTOS_PRIVATE_KEY = `0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20`
+
+func TestAptosSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(AptosSignerTestSuite))
+}
+
+type AptosSignerTestSuite struct {
+	suite.Suite
+}
+
+func (s *AptosSignerTestSuite) TestCreation() {
+	signer, err := NewAptosSigner(APTOS_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+	require.NotNil(s.T(), signer)
+
+	prefixed, err := NewAptosSigner("ed25519-priv-" + APTOS_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), signer.Owner, prefixed.Owner)
+
+	owner, err := signer.GetOwner()
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), signer.GetOwnerLength(), len(owner))
+	require.Len(s.T(), signer.Address(), 66)
+}
+
+func (s *AptosSignerTestSuite) TestSignAndVerify() {
+	signer, err := NewAptosSigner(APTOS_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+
+	data := []byte("to be signed")
+
+	signature, err := signer.Sign(data)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), len(signature), signer.GetSignatureLength())
+
+	// signature is over aptos wallet message, not raw data
+	require.False(s.T(), ed25519.Verify(signer.Owner, data, signature))
+	require.True(s.T(), ed25519.Verify(signer.Owner, AptosMessage(data), signature))
+
+	verifier, err := GetSigner(APTOS, signer.Owner)
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), verifier.Verify(data, signature))
+}
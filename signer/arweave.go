@@ -5,50 +5,106 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
-	"errors"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"math/big"
+	"strings"
 
+	"github.com/Ja7ad/irys/errors"
 	"github.com/lestrrat-go/jwx/jwk"
 )
 
+const (
+	_arweave_key_size         = 4096
+	_arweave_default_exponent = 65537 // "AQAB", used when owner is known without exponent
+)
+
 type ArweaveSigner struct {
 	PrivateKey *rsa.PrivateKey
+	PublicKey  *rsa.PublicKey
 	Owner      []byte
 }
 
-func NewArweaveSigner(privateKeyJWK string) (self *ArweaveSigner, err error) {
-	// Parse the private key
-	self = new(ArweaveSigner)
-	set, err := jwk.Parse([]byte(privateKeyJWK))
+// NewArweaveSigner create arweave signer by rsa private key in JWK (arweave wallet) or PEM (PKCS#1 or PKCS#8) format
+func NewArweaveSigner(privateKey string) (*ArweaveSigner, error) {
+	privateKey = strings.TrimSpace(privateKey)
+	if len(privateKey) == 0 {
+		return nil, errors.ErrPrivateKeyIsEmpty
+	}
+
+	if strings.HasPrefix(privateKey, "-----BEGIN") {
+		return NewArweaveSignerFromPEM([]byte(privateKey))
+	}
+	return NewArweaveSignerFromJWK([]byte(privateKey))
+}
+
+// NewArweaveSignerFromJWK create arweave signer by JWK wallet contain one rsa private key
+func NewArweaveSignerFromJWK(privateKeyJWK []byte) (*ArweaveSigner, error) {
+	set, err := jwk.Parse(privateKeyJWK)
 	if err != nil {
-		return
+		return nil, errors.ErrInvalidArweaveKey
 	}
 	if set.Len() != 1 {
-		err = errors.New("too many keys in signer's wallet")
-		return
+		return nil, errors.ErrArweaveKeySetSize
 	}
 
 	key, ok := set.Get(0)
 	if !ok {
-		err = errors.New("cannot access key in JWK")
-		return
+		return nil, errors.ErrArweaveKeySetSize
+	}
+
+	var rawKey interface{}
+	if err := key.Raw(&rawKey); err != nil {
+		return nil, errors.ErrInvalidArweaveKey
+	}
+
+	return newArweaveSigner(rawKey)
+}
+
+// NewArweaveSignerFromPEM create arweave signer by PEM encoded rsa private key (PKCS#1 or PKCS#8)
+func NewArweaveSignerFromPEM(privateKeyPEM []byte) (*ArweaveSigner, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errors.ErrInvalidArweaveKey
+	}
+	return NewArweaveSignerFromDER(block.Bytes)
+}
+
+// NewArweaveSignerFromDER create arweave signer by DER encoded rsa private key (PKCS#1 or PKCS#8)
+func NewArweaveSignerFromDER(privateKeyDER []byte) (*ArweaveSigner, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(privateKeyDER); err == nil {
+		return newArweaveSigner(key)
 	}
 
-	var rawkey interface{}
-	err = key.Raw(&rawkey)
+	key, err := x509.ParsePKCS8PrivateKey(privateKeyDER)
 	if err != nil {
-		return
+		return nil, errors.ErrInvalidArweaveKey
 	}
+	return newArweaveSigner(key)
+}
 
-	self.PrivateKey, ok = rawkey.(*rsa.PrivateKey)
+func newArweaveSigner(rawKey interface{}) (*ArweaveSigner, error) {
+	privateKey, ok := rawKey.(*rsa.PrivateKey)
 	if !ok {
-		err = errors.New("private ")
-		return
+		return nil, errors.ErrInvalidArweaveKey
+	}
+
+	if privateKey.N.BitLen() != _arweave_key_size {
+		return nil, errors.ErrInvalidArweaveKeySize
 	}
 
-	self.Owner = self.PrivateKey.PublicKey.N.Bytes()
+	return &ArweaveSigner{
+		PrivateKey: privateKey,
+		PublicKey:  &privateKey.PublicKey,
+		Owner:      privateKey.N.Bytes(),
+	}, nil
+}
 
-	return
+// Address return arweave wallet address (base64url sha256 of public key modulus)
+func (self *ArweaveSigner) Address() string {
+	hash := sha256.Sum256(self.Owner)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
 }
 
 func (self *ArweaveSigner) Sign(data []byte) (signature []byte, err error) {
@@ -62,15 +118,26 @@ func (self *ArweaveSigner) Sign(data []byte) (signature []byte, err error) {
 func (self *ArweaveSigner) Verify(data []byte, signature []byte) (err error) {
 	hashed := sha256.Sum256(data)
 
-	ownerPublicKey := &rsa.PublicKey{
-		N: new(big.Int).SetBytes([]byte(self.Owner)),
-		E: 65537, //"AQAB"
-	}
-
-	return rsa.VerifyPSS(ownerPublicKey, crypto.SHA256, hashed[:], []byte(signature), &rsa.PSSOptions{
+	if err := rsa.VerifyPSS(self.publicKey(), crypto.SHA256, hashed[:], signature, &rsa.PSSOptions{
 		SaltLength: rsa.PSSSaltLengthAuto,
 		Hash:       crypto.SHA256,
-	})
+	}); err != nil {
+		return errors.ErrArweaveSignatureMismatch
+	}
+
+	return nil
+}
+
+// publicKey return full public key when it's known, otherwise owner is used as modulus with default exponent
+func (self *ArweaveSigner) publicKey() *rsa.PublicKey {
+	if self.PublicKey != nil {
+		return self.PublicKey
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(self.Owner),
+		E: _arweave_default_exponent,
+	}
 }
 
 func (self *ArweaveSigner) GetOwner() ([]byte, error) {
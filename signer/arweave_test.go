@@ -1,9 +1,15 @@
 package signer
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"testing"
 
+	"github.com/Ja7ad/irys/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -58,3 +64,53 @@ func (s *ArweaveSignerTestSuite) TestSignAndVerify() {
 	err = signer.Verify(data, signature)
 	require.Nil(s.T(), err)
 }
+
+func (s *ArweaveSignerTestSuite) TestPEM() {
+	jwkSigner, err := NewArweaveSigner(EMPTY_ARWEAVE_WALLET)
+	require.Nil(s.T(), err)
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(jwkSigner.PrivateKey)
+	require.Nil(s.T(), err)
+
+	for _, block := range []*pem.Block{
+		{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(jwkSigner.PrivateKey)},
+		{Type: "PRIVATE KEY", Bytes: pkcs8},
+	} {
+		signer, err := NewArweaveSigner(string(pem.EncodeToMemory(block)))
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), jwkSigner.Owner, signer.Owner)
+		require.Equal(s.T(), jwkSigner.Address(), signer.Address())
+	}
+}
+
+func (s *ArweaveSignerTestSuite) TestVerifyWithOwnerOnly() {
+	signer, err := NewArweaveSigner(EMPTY_ARWEAVE_WALLET)
+	require.Nil(s.T(), err)
+
+	data := []byte("to be signed")
+	signature, err := signer.Sign(data)
+	require.Nil(s.T(), err)
+
+	verifier, err := GetSigner(Arweave, signer.Owner)
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), verifier.Verify(data, signature))
+	require.ErrorIs(s.T(), verifier.Verify([]byte("other data"), signature), errors.ErrArweaveSignatureMismatch)
+}
+
+func (s *ArweaveSignerTestSuite) TestInvalidKey() {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(s.T(), err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.Nil(s.T(), err)
+
+	_, err = NewArweaveSigner(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})))
+	require.ErrorIs(s.T(), err, errors.ErrInvalidArweaveKey)
+
+	smallKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(s.T(), err)
+	_, err = NewArweaveSignerFromDER(x509.MarshalPKCS1PrivateKey(smallKey))
+	require.ErrorIs(s.T(), err, errors.ErrInvalidArweaveKeySize)
+
+	_, err = NewArweaveSigner("{}")
+	require.Error(s.T(), err)
+}
@@ -0,0 +1,256 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethereum_crypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	_clef_timeout      = 2 * time.Minute // remote signer may wait for manual approval
+	_clef_content_type = "text/plain"    // data is signed as personal message (eip-191)
+	_clef_owner_probe  = "irys owner"
+)
+
+// ClefSigner is ethereum signer which send signing requests to external signer speaking
+// clef json-rpc api (account_signData, account_signTransaction), private key never enter process.
+// see https://geth.ethereum.org/docs/tools/clef/apis
+type ClefSigner struct {
+	Endpoint string
+	Account  common.Address
+	Client   *http.Client
+
+	mu    sync.Mutex
+	owner []byte
+}
+
+// NewClefSigner create remote ethereum signer by json-rpc endpoint of signer and account address,
+// public key of account is recovered from first signature, or it can be passed as owner to avoid extra request.
+func NewClefSigner(endpoint string, account common.Address, owner []byte) (*ClefSigner, error) {
+	if len(endpoint) == 0 {
+		return nil, errors.ErrRemoteSignerEndpointIsEmpty
+	}
+
+	if len(owner) != 0 {
+		pub, err := ethereum_crypto.UnmarshalPubkey(owner)
+		if err != nil {
+			return nil, errors.ErrFailedToParseEthereumPublicKey
+		}
+		if ethereum_crypto.PubkeyToAddress(*pub) != account {
+			return nil, errors.ErrRemoteSignerAccountMismatch
+		}
+	}
+
+	return &ClefSigner{
+		Endpoint: endpoint,
+		Account:  account,
+		Client:   &http.Client{Timeout: _clef_timeout},
+		owner:    owner,
+	}, nil
+}
+
+// Address return account address of signer
+func (self *ClefSigner) Address() common.Address {
+	return self.Account
+}
+
+func (self *ClefSigner) Sign(data []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), _clef_timeout)
+	defer cancel()
+
+	var signature hexutil.Bytes
+	if err := self.call(ctx, "account_signData", []any{
+		_clef_content_type,
+		self.Account,
+		hexutil.Bytes(data),
+	}, &signature); err != nil {
+		return nil, err
+	}
+
+	if len(signature) != ethereum_crypto.SignatureLength {
+		return nil, errors.ErrEthereumSignatureMismatch
+	}
+
+	// clef return v as 27/28, local signer use 0/1
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+
+	pub, err := ethereum_crypto.Ecrecover(EthereumHash(data), signature)
+	if err != nil {
+		return nil, err
+	}
+	if common.BytesToAddress(ethereum_crypto.Keccak256(pub[1:])[12:]) != self.Account {
+		return nil, errors.ErrRemoteSignerAccountMismatch
+	}
+
+	self.mu.Lock()
+	if len(self.owner) == 0 {
+		self.owner = pub
+	}
+	self.mu.Unlock()
+
+	return signature, nil
+}
+
+// SignTx sign transaction with account_signTransaction and return signed transaction
+func (self *ClefSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := map[string]any{
+		"from":     common.NewMixedcaseAddress(self.Account),
+		"gas":      hexutil.Uint64(tx.Gas()),
+		"gasPrice": (*hexutil.Big)(tx.GasPrice()),
+		"value":    (*hexutil.Big)(tx.Value()),
+		"nonce":    hexutil.Uint64(tx.Nonce()),
+		"data":     hexutil.Bytes(tx.Data()),
+		"chainId":  (*hexutil.Big)(chainID),
+	}
+	if tx.To() != nil {
+		to := common.NewMixedcaseAddress(*tx.To())
+		args["to"] = &to
+	}
+
+	var result struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := self.call(ctx, "account_signTransaction", []any{args}, &result); err != nil {
+		return nil, err
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(result.Raw); err != nil {
+		return nil, err
+	}
+
+	// remote signer must not change transaction
+	if !sameTransaction(tx, signed, chainID) {
+		return nil, errors.ErrRemoteSignerTxMismatch
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if err != nil {
+		return nil, err
+	}
+	if sender != self.Account {
+		return nil, errors.ErrRemoteSignerAccountMismatch
+	}
+
+	return signed, nil
+}
+
+func (self *ClefSigner) Verify(data []byte, signature []byte) error {
+	owner, err := self.GetOwner()
+	if err != nil {
+		return err
+	}
+	return (&EthereumSigner{Owner: owner}).Verify(data, signature)
+}
+
+// GetOwner return uncompressed public key of account, it's recovered with one signing request if not known
+func (self *ClefSigner) GetOwner() ([]byte, error) {
+	self.mu.Lock()
+	owner := self.owner
+	self.mu.Unlock()
+
+	if len(owner) != 0 {
+		return owner, nil
+	}
+
+	if _, err := self.Sign([]byte(_clef_owner_probe)); err != nil {
+		return nil, err
+	}
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.owner, nil
+}
+
+func (self *ClefSigner) GetType() SignatureType {
+	return Ethereum
+}
+
+func (self *ClefSigner) GetSignatureLength() int {
+	return 65
+}
+
+func (self *ClefSigner) GetOwnerLength() int {
+	return 65
+}
+
+// sameTransaction check nonce, recipient, value, data and fees of transactions are equal and signed
+// transaction is for chainID
+func sameTransaction(tx, signed *types.Transaction, chainID *big.Int) bool {
+	if tx.Nonce() != signed.Nonce() || tx.Value().Cmp(signed.Value()) != 0 || !bytes.Equal(tx.Data(), signed.Data()) {
+		return false
+	}
+	if tx.Gas() != signed.Gas() || tx.GasPrice().Cmp(signed.GasPrice()) != 0 ||
+		tx.GasFeeCap().Cmp(signed.GasFeeCap()) != 0 || tx.GasTipCap().Cmp(signed.GasTipCap()) != 0 {
+		return false
+	}
+	if signed.ChainId().Cmp(chainID) != 0 {
+		return false
+	}
+
+	if tx.To() == nil || signed.To() == nil {
+		return tx.To() == signed.To()
+	}
+	return *tx.To() == *signed.To()
+}
+
+type clefRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+type clefResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (self *ClefSigner) call(ctx context.Context, method string, params, result any) error {
+	b, err := json.Marshal(&clefRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, self.Endpoint, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := self.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("remote signer %s: unexpected status %d", method, resp.StatusCode)
+	}
+
+	var response clefResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return err
+	}
+	if response.Error != nil {
+		return fmt.Errorf("remote signer %s: %d: %s", method, response.Error.Code, response.Error.Message)
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
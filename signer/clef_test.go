@@ -0,0 +1,155 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethereum_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestClefSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(ClefSignerTestSuite))
+}
+
+type ClefSignerTestSuite struct {
+	suite.Suite
+	key    *ecdsa.PrivateKey
+	server *httptest.Server
+	// tamper change transaction before it's signed by clef stand-in
+	tamper func(args *clefTxArgs)
+}
+
+type clefTxArgs struct {
+	To       *common.Address `json:"to"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+	Data     hexutil.Bytes   `json:"data"`
+	ChainID  *hexutil.Big    `json:"chainId"`
+}
+
+func (s *ClefSignerTestSuite) SetupTest() {
+	key, err := ethereum_crypto.GenerateKey()
+	require.Nil(s.T(), err)
+	s.key = key
+	s.tamper = nil
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveClef))
+}
+
+func (s *ClefSignerTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// serveClef is in-process stand-in of clef, it sign requests with local key
+func (s *ClefSignerTestSuite) serveClef(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	require.Nil(s.T(), json.NewDecoder(r.Body).Decode(&req))
+
+	var result any
+	switch req.Method {
+	case "account_signData":
+		var data hexutil.Bytes
+		require.Nil(s.T(), json.Unmarshal(req.Params[2], &data))
+		signature, err := ethereum_crypto.Sign(EthereumHash(data), s.key)
+		require.Nil(s.T(), err)
+		signature[64] += 27
+		result = hexutil.Bytes(signature)
+	case "account_signTransaction":
+		var args clefTxArgs
+		require.Nil(s.T(), json.Unmarshal(req.Params[0], &args))
+		if s.tamper != nil {
+			s.tamper(&args)
+		}
+		tx := types.NewTransaction(uint64(args.Nonce), *args.To, args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), args.Data)
+		signed, err := types.SignTx(tx, types.NewEIP155Signer(args.ChainID.ToInt()), s.key)
+		require.Nil(s.T(), err)
+		raw, err := signed.MarshalBinary()
+		require.Nil(s.T(), err)
+		result = map[string]any{"raw": hexutil.Bytes(raw)}
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 1, "result": result})
+}
+
+func (s *ClefSignerTestSuite) TestSignAndVerify() {
+	account := ethereum_crypto.PubkeyToAddress(s.key.PublicKey)
+	signer, err := NewClefSigner(s.server.URL, account, nil)
+	require.Nil(s.T(), err)
+
+	owner, err := signer.GetOwner()
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), ethereum_crypto.FromECDSAPub(&s.key.PublicKey), owner)
+
+	data := []byte("to be signed")
+	signature, err := signer.Sign(data)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), signer.GetSignatureLength(), len(signature))
+
+	require.Nil(s.T(), signer.Verify(data, signature))
+	require.Nil(s.T(), (&EthereumSigner{Owner: owner}).Verify(data, signature))
+}
+
+func (s *ClefSignerTestSuite) TestSignTx() {
+	account := ethereum_crypto.PubkeyToAddress(s.key.PublicKey)
+	signer, err := NewClefSigner(s.server.URL, account, nil)
+	require.Nil(s.T(), err)
+
+	to := common.HexToAddress("0x853758425e953739F5438fd6fd0Efe04A477b039")
+	tx := types.NewTransaction(3, to, big.NewInt(1000), 21000, big.NewInt(1e9), nil)
+
+	signed, err := signer.SignTx(context.Background(), tx, big.NewInt(137))
+	require.Nil(s.T(), err)
+
+	sender, err := types.Sender(types.NewEIP155Signer(big.NewInt(137)), signed)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), account, sender)
+	require.Equal(s.T(), tx.Nonce(), signed.Nonce())
+}
+
+func (s *ClefSignerTestSuite) TestSignTxMismatch() {
+	account := ethereum_crypto.PubkeyToAddress(s.key.PublicKey)
+	signer, err := NewClefSigner(s.server.URL, account, nil)
+	require.Nil(s.T(), err)
+
+	to := common.HexToAddress("0x853758425e953739F5438fd6fd0Efe04A477b039")
+	tx := types.NewTransaction(3, to, big.NewInt(1000), 21000, big.NewInt(1e9), nil)
+
+	tampers := map[string]func(args *clefTxArgs){
+		"nonce":     func(args *clefTxArgs) { args.Nonce++ },
+		"value":     func(args *clefTxArgs) { args.Value = (*hexutil.Big)(big.NewInt(2000)) },
+		"data":      func(args *clefTxArgs) { args.Data = []byte{1} },
+		"recipient": func(args *clefTxArgs) { args.To = &account },
+		"gas":       func(args *clefTxArgs) { args.Gas++ },
+		"gas price": func(args *clefTxArgs) { args.GasPrice = (*hexutil.Big)(big.NewInt(2e9)) },
+		"chain id":  func(args *clefTxArgs) { args.ChainID = (*hexutil.Big)(big.NewInt(1)) },
+	}
+	for name, tamper := range tampers {
+		s.tamper = tamper
+		_, err := signer.SignTx(context.Background(), tx, big.NewInt(137))
+		require.ErrorIs(s.T(), err, errors.ErrRemoteSignerTxMismatch, name)
+	}
+}
+
+func (s *ClefSignerTestSuite) TestAccountMismatch() {
+	other := common.HexToAddress("0x853758425e953739F5438fd6fd0Efe04A477b039")
+	signer, err := NewClefSigner(s.server.URL, other, nil)
+	require.Nil(s.T(), err)
+
+	_, err = signer.Sign([]byte("to be signed"))
+	require.ErrorIs(s.T(), err, errors.ErrRemoteSignerAccountMismatch)
+}
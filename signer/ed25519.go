@@ -0,0 +1,59 @@
+package signer
+
+import (
+	"crypto/ed25519"
+
+	"github.com/Ja7ad/irys/errors"
+)
+
+// Ed25519Signer sign data items with generic ed25519 signature type, owner is raw public key
+type Ed25519Signer struct {
+	PrivateKey ed25519.PrivateKey
+	Owner      []byte
+}
+
+// NewEd25519Signer create signer from 32 bytes seed or 64 bytes ed25519 private key
+func NewEd25519Signer(privateKey []byte) (self *Ed25519Signer, err error) {
+	self = new(Ed25519Signer)
+
+	switch len(privateKey) {
+	case ed25519.SeedSize:
+		self.PrivateKey = ed25519.NewKeyFromSeed(privateKey)
+	case ed25519.PrivateKeySize:
+		self.PrivateKey = ed25519.NewKeyFromSeed(privateKey[:ed25519.SeedSize])
+	default:
+		err = errors.ErrInvalidEd25519PrivateKey
+		return
+	}
+
+	self.Owner = self.PrivateKey.Public().(ed25519.PublicKey)
+
+	return
+}
+
+func (self *Ed25519Signer) Sign(data []byte) (signature []byte, err error) {
+	return ed25519.Sign(self.PrivateKey, data), nil
+}
+
+func (self *Ed25519Signer) Verify(data []byte, signature []byte) (err error) {
+	if len(self.Owner) != ed25519.PublicKeySize || !ed25519.Verify(self.Owner, data, signature) {
+		return errors.ErrEd25519SignatureMismatch
+	}
+	return
+}
+
+func (self *Ed25519Signer) GetOwner() ([]byte, error) {
+	return self.Owner, nil
+}
+
+func (self *Ed25519Signer) GetType() SignatureType {
+	return ED25519
+}
+
+func (self *Ed25519Signer) GetSignatureLength() int {
+	return ed25519.SignatureSize
+}
+
+func (self *Ed25519Signer) GetOwnerLength() int {
+	return ed25519.PublicKeySize
+}
@@ -0,0 +1,40 @@
+package signer
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/Ja7ad/irys/utils/base58"
+)
+
+const _near_key_prefix = "ed25519:"
+
+// NearSigner sign data items with generic ed25519 signature type, owner is raw public key
+type NearSigner struct {
+	Ed25519Signer
+}
+
+// NewNearSigner create signer from near private key in "ed25519:<base58>" format (prefix is optional)
+func NewNearSigner(privateKey string) (self *NearSigner, err error) {
+	buf, err := base58.Decode(strings.TrimPrefix(privateKey, _near_key_prefix))
+	if err != nil {
+		return
+	}
+
+	s, err := NewEd25519Signer(buf)
+	if err != nil {
+		return
+	}
+
+	return &NearSigner{Ed25519Signer: *s}, nil
+}
+
+// PublicKey return near public key of signer in "ed25519:<base58>" format
+func (self *NearSigner) PublicKey() string {
+	return _near_key_prefix + base58.Encode(self.Owner)
+}
+
+// Address return implicit near account id of signer (hex of public key)
+func (self *NearSigner) Address() string {
+	return hex.EncodeToString(self.Owner)
+}
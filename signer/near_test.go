@@ -0,0 +1,42 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const NEAR_PRIVATE_KEY = `ed25519:` + SOLANA_PRIVATE_KEY
+
+func TestNearSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(NearSignerTestSuite))
+}
+
+type NearSignerTestSuite struct {
+	suite.Suite
+}
+
+func (s *NearSignerTestSuite) TestCreation() {
+	signer, err := NewNearSigner(NEAR_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+	require.NotNil(s.T(), signer)
+	require.Equal(s.T(), NEAR, signer.GetType())
+	require.Equal(s.T(), "ed25519:"+SOLANA_ADDRESS, signer.PublicKey())
+	require.Len(s.T(), signer.Address(), 64)
+}
+
+func (s *NearSignerTestSuite) TestSignAndVerify() {
+	signer, err := NewNearSigner(NEAR_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+
+	data := []byte("to be signed")
+
+	signature, err := signer.Sign(data)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), len(signature), signer.GetSignatureLength())
+
+	verifier, err := GetSigner(NEAR, signer.Owner)
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), verifier.Verify(data, signature))
+}
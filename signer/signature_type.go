@@ -5,13 +5,24 @@ import "strconv"
 type SignatureType int
 
 // Values are taken from bundlr library
-// https://github.com/Bundlr-Network/arbundles/blob/5413fe576098355f7502a5fa9456f8db6a861492/src/constants.ts#L4
+// https://github.com/Irys-xyz/arbundles/blob/master/src/constants.ts
 const (
 	Arweave SignatureType = iota + 1
-	APTOS
+	ED25519
 	Ethereum
 	SOLANA
-	NEAR
+	APTOS // APTOS is injected aptos signature type of bundlr
+	MULTI_APTOS
+	TYPED_ETHEREUM // TYPED_ETHEREUM is eip-712 typed data signature, owner is address
+)
+
+// KYVE is secp256k1 signature type of kyve, it's signed and verified same as ethereum (EIP-191 message)
+const KYVE SignatureType = 101
+
+// NEAR and ALGORAND data items are signed by generic ed25519 signature type
+const (
+	NEAR     = ED25519
+	ALGORAND = ED25519
 )
 
 func (self SignatureType) Bytes() []byte {
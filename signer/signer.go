@@ -23,6 +23,22 @@ func GetSigner(SignatureType SignatureType, owner []byte) (signer Signer, err er
 		signer = &EthereumSigner{
 			Owner: owner,
 		}
+	case TYPED_ETHEREUM:
+		signer = &TypedEthereumSigner{
+			Owner: owner,
+		}
+	case ED25519:
+		signer = &Ed25519Signer{
+			Owner: owner,
+		}
+	case SOLANA:
+		signer = &SolanaSigner{
+			Ed25519Signer{Owner: owner},
+		}
+	case APTOS:
+		signer = &AptosSigner{
+			Ed25519Signer{Owner: owner},
+		}
 	default:
 		err = errors.ErrUnsupportedSignatureType
 	}
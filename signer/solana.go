@@ -0,0 +1,50 @@
+package signer
+
+import (
+	"encoding/hex"
+
+	"github.com/Ja7ad/irys/utils/base58"
+)
+
+// SolanaSigner sign data items with solana signature type, signature is over hex encoding of message
+// same as HexSolanaSigner of arbundles (solana wallets sign printable messages only)
+type SolanaSigner struct {
+	Ed25519Signer
+}
+
+// NewSolanaSigner create signer from base58 solana secret key (64 bytes) or seed (32 bytes)
+func NewSolanaSigner(privateKeyBase58 string) (self *SolanaSigner, err error) {
+	buf, err := base58.Decode(privateKeyBase58)
+	if err != nil {
+		return
+	}
+
+	s, err := NewEd25519Signer(buf)
+	if err != nil {
+		return
+	}
+
+	return &SolanaSigner{Ed25519Signer: *s}, nil
+}
+
+// Address return base58 solana address of signer
+func (self *SolanaSigner) Address() string {
+	return base58.Encode(self.Owner)
+}
+
+func (self *SolanaSigner) Sign(data []byte) (signature []byte, err error) {
+	return self.Ed25519Signer.Sign(SolanaMessage(data))
+}
+
+func (self *SolanaSigner) Verify(data []byte, signature []byte) (err error) {
+	return self.Ed25519Signer.Verify(SolanaMessage(data), signature)
+}
+
+// SolanaMessage return full message signed by solana wallets for data
+func SolanaMessage(data []byte) []byte {
+	return []byte(hex.EncodeToString(data))
+}
+
+func (self *SolanaSigner) GetType() SignatureType {
+	return SOLANA
+}
@@ -0,0 +1,63 @@
+package signer
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	SOLANA_PRIVATE_KEY = `2Ana1pUpv2ZbMVkwF5FXapYeBEjdxDatLn7nvJkhgTSdZd8hbDHTd21as7EAsg7ypityqfsw2pMQKJcVDVcAEsd`
+	SOLANA_ADDRESS     = `9C6hybhQ6Aycep9jaUnP6uL9ZYvDjUp1aSkFWPUFJtpj`
+)
+
+func TestSolanaSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(SolanaSignerTestSuite))
+}
+
+type SolanaSignerTestSuite struct {
+	suite.Suite
+}
+
+func (s *SolanaSignerTestSuite) TestCreation() {
+	signer, err := NewSolanaSigner(SOLANA_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+	require.NotNil(s.T(), signer)
+
+	owner, err := signer.GetOwner()
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), signer.GetOwnerLength(), len(owner))
+	require.Equal(s.T(), SOLANA_ADDRESS, signer.Address())
+}
+
+func (s *SolanaSignerTestSuite) TestSignAndVerify() {
+	signer, err := NewSolanaSigner(SOLANA_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+
+	data := []byte("to be signed")
+
+	signature, err := signer.Sign(data)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), len(signature), signer.GetSignatureLength())
+
+	verifier, err := GetSigner(SOLANA, signer.Owner)
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), verifier.Verify(data, signature))
+	require.NotNil(s.T(), verifier.Verify([]byte("other data"), signature))
+}
+
+func (s *SolanaSignerTestSuite) TestSignHexMessage() {
+	signer, err := NewSolanaSigner(SOLANA_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+
+	data := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	signature, err := signer.Sign(data)
+	require.Nil(s.T(), err)
+
+	// signature type 4 is signed over hex encoding of message like HexSolanaSigner of arbundles
+	require.True(s.T(), ed25519.Verify(signer.Owner, []byte("deadbeef"), signature))
+	require.False(s.T(), ed25519.Verify(signer.Owner, data, signature))
+}
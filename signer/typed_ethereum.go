@@ -0,0 +1,121 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereum_crypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// eip-712 domain and type of bundlr typed data, same as arbundles TypedEthereumSigner
+// https://github.com/Irys-xyz/arbundles/blob/master/src/signing/chains/TypedEthereumSigner.ts
+const (
+	_typed_domain_type  = "EIP712Domain(string name,string version)"
+	_typed_domain_name  = "Bundlr"
+	_typed_domain_ver   = "1"
+	_typed_message_type = "Bundlr(bytes Transaction hash,address address)"
+)
+
+// TypedEthereumSigner sign data items with eip-712 typed data (eth_signTypedData), owner is lowercase hex address
+type TypedEthereumSigner struct {
+	PrivateKey *ecdsa.PrivateKey
+	Owner      []byte
+}
+
+// NewTypedEthereumSigner create typed data ethereum signer by hex private key
+func NewTypedEthereumSigner(privateKeyHex string) (*TypedEthereumSigner, error) {
+	buf, err := hexutil.Decode(privateKeyHex)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := ethereum_crypto.ToECDSA(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedEthereumSigner{
+		PrivateKey: privateKey,
+		Owner:      typedEthereumOwner(ethereum_crypto.PubkeyToAddress(privateKey.PublicKey)),
+	}, nil
+}
+
+// Address return account address of signer
+func (self *TypedEthereumSigner) Address() common.Address {
+	return common.HexToAddress(string(self.Owner))
+}
+
+func (self *TypedEthereumSigner) Sign(data []byte) ([]byte, error) {
+	signature, err := ethereum_crypto.Sign(TypedEthereumHash(self.Owner, data), self.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// wallets return v as 27/28 for typed data
+	signature[64] += 27
+	return signature, nil
+}
+
+func (self *TypedEthereumSigner) Verify(data []byte, signature []byte) error {
+	if len(signature) != ethereum_crypto.SignatureLength {
+		return errors.ErrEthereumSignatureMismatch
+	}
+
+	sig := append([]byte{}, signature...)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	pub, err := ethereum_crypto.SigToPub(TypedEthereumHash(self.Owner, data), sig)
+	if err != nil {
+		return errors.ErrEthereumSignatureMismatch
+	}
+
+	if !bytes.Equal(typedEthereumOwner(ethereum_crypto.PubkeyToAddress(*pub)), bytes.ToLower(self.Owner)) {
+		return errors.ErrEthereumSignatureMismatch
+	}
+
+	return nil
+}
+
+func (self *TypedEthereumSigner) GetOwner() ([]byte, error) {
+	return self.Owner, nil
+}
+
+func (self *TypedEthereumSigner) GetType() SignatureType {
+	return TYPED_ETHEREUM
+}
+
+func (self *TypedEthereumSigner) GetSignatureLength() int {
+	return 65
+}
+
+func (self *TypedEthereumSigner) GetOwnerLength() int {
+	return 42
+}
+
+// TypedEthereumHash return eip-712 hash of bundlr typed data for owner address and message
+func TypedEthereumHash(owner, message []byte) []byte {
+	domainSeparator := ethereum_crypto.Keccak256(
+		ethereum_crypto.Keccak256([]byte(_typed_domain_type)),
+		ethereum_crypto.Keccak256([]byte(_typed_domain_name)),
+		ethereum_crypto.Keccak256([]byte(_typed_domain_ver)),
+	)
+
+	address := common.HexToAddress(string(owner))
+	structHash := ethereum_crypto.Keccak256(
+		ethereum_crypto.Keccak256([]byte(_typed_message_type)),
+		ethereum_crypto.Keccak256(message),
+		common.LeftPadBytes(address.Bytes(), 32),
+	)
+
+	return ethereum_crypto.Keccak256([]byte("\x19\x01"), domainSeparator, structHash)
+}
+
+func typedEthereumOwner(address common.Address) []byte {
+	return []byte(strings.ToLower(address.Hex()))
+}
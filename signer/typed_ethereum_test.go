@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const TYPED_ETHEREUM_PRIVATE_KEY = "0x8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f"
+
+func TestTypedEthereumSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(TypedEthereumSignerTestSuite))
+}
+
+type TypedEthereumSignerTestSuite struct {
+	suite.Suite
+}
+
+func (s *TypedEthereumSignerTestSuite) TestCreation() {
+	signer, err := NewTypedEthereumSigner(TYPED_ETHEREUM_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+
+	owner, err := signer.GetOwner()
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), signer.GetOwnerLength(), len(owner))
+	require.Equal(s.T(), "0x63fac9201494f0bd17b9892b9fae4d52fe3bd377", string(owner))
+	require.Equal(s.T(), TYPED_ETHEREUM, signer.GetType())
+}
+
+func (s *TypedEthereumSignerTestSuite) TestHashMatchEIP712() {
+	signer, err := NewTypedEthereumSigner(TYPED_ETHEREUM_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+
+	message := []byte("to be signed")
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "version", Type: "string"}},
+			"Bundlr":       {{Name: "Transaction hash", Type: "bytes"}, {Name: "address", Type: "address"}},
+		},
+		PrimaryType: "Bundlr",
+		Domain:      apitypes.TypedDataDomain{Name: "Bundlr", Version: "1"},
+		Message: apitypes.TypedDataMessage{
+			"Transaction hash": hexutil.Encode(message),
+			"address":          string(signer.Owner),
+		},
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), hash, TypedEthereumHash(signer.Owner, message))
+}
+
+func (s *TypedEthereumSignerTestSuite) TestSignAndVerify() {
+	signer, err := NewTypedEthereumSigner(TYPED_ETHEREUM_PRIVATE_KEY)
+	require.Nil(s.T(), err)
+
+	data := []byte("to be signed")
+	signature, err := signer.Sign(data)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), signer.GetSignatureLength(), len(signature))
+
+	verifier, err := GetSigner(TYPED_ETHEREUM, signer.Owner)
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), verifier.Verify(data, signature))
+
+	// recovery id without 27 offset is accepted too
+	signature[64] -= 27
+	require.Nil(s.T(), verifier.Verify(data, signature))
+
+	require.ErrorIs(s.T(), verifier.Verify([]byte("other data"), signature), errors.ErrEthereumSignatureMismatch)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Ja7ad/irys/errors"
 	"github.com/Ja7ad/irys/signer"
+	"github.com/Ja7ad/irys/verifier"
 )
 
 type BundleItem struct {
@@ -91,12 +92,12 @@ func (self *BundleItem) NestBundles(dataItems []*BundleItem) (err error) {
 }
 
 func (self *BundleItem) Size() (out int) {
-	s, err := signer.GetSigner(self.SignatureType, nil)
+	signatureLength, ownerLength, err := verifier.Lengths(self.SignatureType)
 	if err != nil {
 		return
 	}
 
-	out = 2 /*signature type */ + s.GetSignatureLength() + s.GetOwnerLength() + 1 /*target flag*/ + 1 /*anchor flag*/ + len(self.Data) + 8 /*len tags*/ + 8 /*len tags bytes*/
+	out = 2 /*signature type */ + signatureLength + ownerLength + 1 /*target flag*/ + 1 /*anchor flag*/ + len(self.Data) + 8 /*len tags*/ + 8 /*len tags bytes*/
 	if len(self.Target) > 0 {
 		out += len(self.Target)
 	}
@@ -166,18 +167,7 @@ func (self *BundleItem) sign(signer signer.Signer) (id, signature []byte, err er
 		return
 	}
 
-	values := []any{
-		"dataitem",
-		"1",
-		self.SignatureType.Bytes(),
-		self.Owner,
-		self.Target,
-		self.Anchor,
-		self.tagsBytes,
-		self.Data,
-	}
-
-	deepHash := DeepHash(values)
+	deepHash := DeepHash(append(self.signatureHeader(), []byte(self.Data)))
 
 	// Compute the signature
 	signature, err = signer.Sign(deepHash[:])
@@ -210,6 +200,12 @@ func (self *BundleItem) Sign(signer signer.Signer) (err error) {
 		// Already signed
 		return
 	}
+
+	// irys node reject invalid items, so don't sign them
+	if err = self.Validate(); err != nil {
+		return
+	}
+
 	self.SignatureType = signer.GetType()
 	self.Owner, err = signer.GetOwner()
 	if err != nil {
@@ -300,164 +296,147 @@ func (self *BundleItem) Encode(out io.Writer) (err error) {
 	return
 }
 
-func (self *BundleItem) Unmarshal(buf []byte) (err error) {
+func (self *BundleItem) Unmarshal(buf []byte, opts ...DecodeOption) (err error) {
 	reader := bytes.NewReader(buf)
-	return self.UnmarshalFromReader(reader)
+	return self.UnmarshalFromReader(reader, opts...)
 }
 
-// Reverse operation of Reader
-func (self *BundleItem) UnmarshalFromReader(reader io.Reader) (err error) {
-	// Signature type
-	signatureType := make([]byte, 2)
-	n, err := reader.Read(signatureType)
-	if err != nil {
+// UnmarshalFromReader is reverse operation of Reader, length fields are checked against ANS-104 limits
+// before allocation, so it's safe for untrusted input. data size is limited by WithMaxDataSize.
+func (self *BundleItem) UnmarshalFromReader(reader io.Reader, opts ...DecodeOption) (err error) {
+	options := newDecodeOptions(opts...)
+
+	if err = self.decodeHeader(reader); err != nil {
 		return
 	}
-	if n < 2 {
-		err = errors.ErrNotEnoughBytesForSignatureType
+
+	// The rest is just data
+	var data bytes.Buffer
+	if _, err = data.ReadFrom(newLimitedDataReader(reader, options.maxDataSize)); err != nil {
 		return
 	}
-	self.SignatureType = signer.SignatureType(binary.LittleEndian.Uint16(signatureType))
+	self.Data = data.Bytes()
 
-	// Signer, used only getting config
-	s, err := signer.GetSigner(self.SignatureType, nil)
-	if err != nil {
+	return
+}
+
+// decodeHeader decode all fields of data item before data and calculate id
+func (self *BundleItem) decodeHeader(reader io.Reader) (err error) {
+	// Signature type
+	signatureType := make([]byte, 2)
+	if err = readFull(reader, signatureType, errors.ErrNotEnoughBytesForSignatureType); err != nil {
 		return
 	}
+	self.SignatureType = signer.SignatureType(binary.LittleEndian.Uint16(signatureType))
 
-	// Signature (different length depending on the signature type)
-	self.Signature = make([]byte, s.GetSignatureLength())
-	n, err = reader.Read(self.Signature)
+	signatureLength, ownerLength, err := verifier.Lengths(self.SignatureType)
 	if err != nil {
 		return
 	}
-	if n < s.GetSignatureLength() {
-		err = errors.ErrNotEnoughBytesForSignature
+
+	// Signature (different length depending on the signature type)
+	self.Signature = make([]byte, signatureLength)
+	if err = readFull(reader, self.Signature, errors.ErrNotEnoughBytesForSignature); err != nil {
 		return
 	}
 
 	// Owner - public key (different length depending on the signature type)
-	self.Owner = make([]byte, s.GetOwnerLength())
-	n, err = reader.Read(self.Owner)
-	if err != nil {
-		return
-	}
-	if n < s.GetOwnerLength() {
-		err = errors.ErrNotEnoughBytesForOwner
+	self.Owner = make([]byte, ownerLength)
+	if err = readFull(reader, self.Owner, errors.ErrNotEnoughBytesForOwner); err != nil {
 		return
 	}
 
 	// Target (it's optional)
-	isTargetPresent := make([]byte, 1)
-	n, err = reader.Read(isTargetPresent)
+	self.Target, err = readOptional(reader, errors.ErrNotEnoughBytesForTargetFlag, errors.ErrInvalidTargetFlag, errors.ErrNotEnoughBytesForTarget)
 	if err != nil {
-		return err
-	}
-	if n < 1 {
-		err = errors.ErrNotEnoughBytesForTargetFlag
 		return
 	}
 
-	if isTargetPresent[0] == 0 {
-		self.Target = []byte{}
-	} else {
-		// Value present
-		self.Target = make([]byte, 32)
-		n, err = reader.Read(self.Target)
-		if err != nil {
-			return err
-		}
-		if n < 32 {
-			err = errors.ErrNotEnoughBytesForTarget
-			return
-		}
-	}
-
 	// Anchor (it's optional)
-	isAnchorPresent := make([]byte, 1)
-	n, err = reader.Read(isAnchorPresent)
+	self.Anchor, err = readOptional(reader, errors.ErrNotEnoughBytesForAnchorFlag, errors.ErrInvalidAnchorFlag, errors.ErrNotEnoughBytesForAnchor)
 	if err != nil {
-		return err
-	}
-	if n < 1 {
-		err = errors.ErrNotEnoughBytesForAnchorFlag
 		return
 	}
 
-	if isAnchorPresent[0] == 0 {
-		self.Anchor = []byte{}
-	} else {
-		// Value present
-		self.Anchor = make([]byte, 32)
-		n, err = reader.Read(self.Anchor)
-		if err != nil {
-			return err
-		}
-		if n < 32 {
-			err = errors.ErrNotEnoughBytesForAnchor
-			return
-		}
-	}
-
 	// Length of the tags slice
 	numTagsBuffer := make([]byte, 8)
-	n, err = reader.Read(numTagsBuffer)
-	if err != nil {
+	if err = readFull(reader, numTagsBuffer, errors.ErrNotEnoughBytesForNumberOfTags); err != nil {
 		return
 	}
-	if n < 8 {
-		err = errors.ErrNotEnoughBytesForNumberOfTags
+	numTags := binary.LittleEndian.Uint64(numTagsBuffer)
+	if numTags > _max_tags {
+		err = errors.ErrVerifyTooManyTags
 		return
 	}
-	numTags := int(binary.LittleEndian.Uint64(numTagsBuffer))
 
 	// Size of encoded tags
 	numTagsBytesBuffer := make([]byte, 8)
-	n, err = reader.Read(numTagsBytesBuffer)
-	if err != nil {
+	if err = readFull(reader, numTagsBytesBuffer, errors.ErrNotEnoughBytesForNumberOfTagBytes); err != nil {
 		return
 	}
-	if n < 8 {
-		err = errors.ErrNotEnoughBytesForNumberOfTagBytes
+	numTagsBytes := binary.LittleEndian.Uint64(numTagsBytesBuffer)
+	if numTagsBytes > _max_tags_bytes {
+		err = errors.ErrVerifyTooManyTagsBytes
+		return
+	}
+	if (numTags == 0) != (numTagsBytes == 0) {
+		err = errors.ErrTagsCountMismatch
 		return
 	}
-	numTagsBytes := int(binary.LittleEndian.Uint64(numTagsBytesBuffer))
 
 	// Tags
-	self.Tags = make([]Tag, numTags)
+	self.Tags = make([]Tag, 0, numTags)
+	self.tagsBytes = make([]byte, numTagsBytes)
+	if err = readFull(reader, self.tagsBytes, errors.ErrNotEnoughBytesForTags); err != nil {
+		return
+	}
 	if numTags > 0 {
-		// Read tags
-		self.tagsBytes = make([]byte, numTagsBytes)
-		n, err = reader.Read(self.tagsBytes)
+		self.Tags, err = decodeTags(self.tagsBytes)
 		if err != nil {
 			return
 		}
-		if n < numTagsBytes {
-			err = errors.ErrNotEnoughBytesForTags
-			return
-		}
-
-		// Parse tags
-		err = self.Tags.Unmarshal(self.tagsBytes)
-		if err != nil {
+		if uint64(len(self.Tags)) != numTags {
+			err = errors.ErrTagsCountMismatch
 			return
 		}
 	}
 
-	// The rest is just data
-	var data bytes.Buffer
-	_, err = data.ReadFrom(reader)
-	if err != nil {
-		return
-	}
-	self.Data = data.Bytes()
-
 	// Id is calculated from the signature
 	idArray := sha256.Sum256(self.Signature)
 	self.Id = idArray[:]
 	return
 }
 
+// readFull read exactly len(buf) bytes, short read return errShort
+func readFull(reader io.Reader, buf []byte, errShort error) error {
+	_, err := io.ReadFull(reader, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errShort
+	}
+	return err
+}
+
+// readOptional read presence flag (0 or 1) and 32 bytes value if present
+func readOptional(reader io.Reader, errShortFlag, errInvalidFlag, errShortValue error) ([]byte, error) {
+	flag := make([]byte, 1)
+	if err := readFull(reader, flag, errShortFlag); err != nil {
+		return nil, err
+	}
+
+	switch flag[0] {
+	case 0:
+		return []byte{}, nil
+	case 1:
+		value := make([]byte, 32)
+		if err := readFull(reader, value, errShortValue); err != nil {
+			return nil, err
+		}
+		return value, nil
+	default:
+		return nil, errInvalidFlag
+	}
+}
+
 // https://github.com/ArweaveTeam/arweave-standards/blob/master/ans/ANS-104.md#21-verifying-a-dataitem
 func (self *BundleItem) Verify() (err error) {
 	idArray := sha256.Sum256(self.Signature)
@@ -466,45 +445,19 @@ func (self *BundleItem) Verify() (err error) {
 		return
 	}
 
-	// an anchor isn't more than 32 bytes
-	// with this lib it has to be 0 or 32bytes
-	if len(self.Anchor) != 0 && len(self.Anchor) != 32 {
-		err = errors.ErrVerifyBadAnchorLength
+	// an anchor and target aren't more than 32 bytes
+	// with this lib they have to be 0 or 32bytes
+	if err = self.Validate(); err != nil {
 		return
 	}
 
-	// Tags
-	if len(self.Tags) > 128 {
-		err = errors.ErrVerifyTooManyTags
-		return
-	}
-
-	for _, tag := range self.Tags {
-		if len(tag.Name) == 0 {
-			err = errors.ErrVerifyEmptyTagName
-			return
-		}
-		if len(tag.Name) > 1024 {
-			err = errors.ErrVerifyTooLongTagName
-			return
-		}
-		if len(tag.Value) == 0 {
-			err = errors.ErrVerifyEmptyTagValue
-			return
-		}
-		if len(tag.Value) > 3072 {
-			err = errors.ErrVerifyTooLongTagValue
-			return
-		}
-	}
-
 	// Bundlr won't accept more tags than 4KB, so check that
 	err = self.ensureTagsSerialized()
 	if err != nil {
 		return
 	}
-	if len(self.tagsBytes) > 4096 {
-		err = errors.ErrVerifyTooManyTagsBytes
+	if len(self.tagsBytes) > _max_tags_bytes {
+		err = newValidationError(FieldTags, -1, errors.ErrVerifyTooManyTagsBytes)
 		return
 	}
 
@@ -517,7 +470,14 @@ func (self *BundleItem) VerifySignature() (err error) {
 		return
 	}
 
-	values := []any{
+	deepHash := DeepHash(append(self.signatureHeader(), []byte(self.Data)))
+
+	return verifier.Verify(self.SignatureType, self.Owner, deepHash[:], self.Signature)
+}
+
+// signatureHeader return deep hash values of data item which are signed before data
+func (self *BundleItem) signatureHeader() []any {
+	return []any{
 		"dataitem",
 		"1",
 		self.SignatureType.Bytes(),
@@ -525,17 +485,7 @@ func (self *BundleItem) VerifySignature() (err error) {
 		self.Target,
 		self.Anchor,
 		self.tagsBytes,
-		self.Data,
 	}
-
-	deepHash := DeepHash(values)
-
-	s, err := signer.GetSigner(self.SignatureType, self.Owner)
-	if err != nil {
-		return
-	}
-
-	return s.Verify(deepHash[:], self.Signature)
 }
 
 func (self *BundleItem) GetTag(name string) (value string, found bool) {
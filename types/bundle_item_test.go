@@ -0,0 +1,190 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"testing/iotest"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleItem_VerifyAllSignatureTypes(t *testing.T) {
+	eth, err := signer.NewEthereumSigner("0x8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f")
+	require.NoError(t, err)
+	typed, err := signer.NewTypedEthereumSigner("0x8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f")
+	require.NoError(t, err)
+	sol, err := signer.NewSolanaSigner("2Ana1pUpv2ZbMVkwF5FXapYeBEjdxDatLn7nvJkhgTSdZd8hbDHTd21as7EAsg7ypityqfsw2pMQKJcVDVcAEsd")
+	require.NoError(t, err)
+	aptos, err := signer.NewAptosSigner("0x0b1fd9bd37ab4ad03f6fe5c17a1b1ad0e3a7e8a8a2ad34b2bd5bb2a1cc1e7d3f")
+	require.NoError(t, err)
+
+	for _, s := range []signer.Signer{eth, typed, sol, aptos} {
+		item := &BundleItem{
+			Data: Base64String("hello irys"),
+			Tags: Tags{{Name: "Content-Type", Value: "text/plain"}},
+		}
+		require.NoError(t, item.Sign(s))
+
+		reader, err := item.Reader()
+		require.NoError(t, err)
+		raw := reader.Bytes()
+		require.Equal(t, item.Size(), len(raw))
+
+		decoded := new(BundleItem)
+		require.NoError(t, decoded.Unmarshal(raw))
+		require.Equal(t, s.GetType(), decoded.SignatureType)
+		require.NoError(t, decoded.VerifySignature())
+	}
+}
+
+// kyveSigner sign with ethereum key and kyve signature type like KyveSigner of arbundles
+type kyveSigner struct {
+	*signer.EthereumSigner
+}
+
+func (kyveSigner) GetType() signer.SignatureType {
+	return signer.KYVE
+}
+
+func TestBundleItem_Kyve(t *testing.T) {
+	eth, err := signer.NewEthereumSigner("0x8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f")
+	require.NoError(t, err)
+
+	item := &BundleItem{Data: Base64String("hello kyve")}
+	require.NoError(t, item.Sign(kyveSigner{eth}))
+
+	reader, err := item.Reader()
+	require.NoError(t, err)
+
+	decoded := new(BundleItem)
+	require.NoError(t, decoded.UnmarshalFromReader(bytes.NewReader(reader.Bytes())))
+	require.Equal(t, signer.KYVE, decoded.SignatureType)
+	require.NoError(t, decoded.VerifySignature())
+}
+
+func testSignedItem(t testing.TB) []byte {
+	s, err := signer.NewSolanaSigner("2Ana1pUpv2ZbMVkwF5FXapYeBEjdxDatLn7nvJkhgTSdZd8hbDHTd21as7EAsg7ypityqfsw2pMQKJcVDVcAEsd")
+	require.NoError(t, err)
+
+	item := &BundleItem{
+		Target: make([]byte, 32),
+		Anchor: []byte("01234567890123456789012345678901"),
+		Data:   Base64String("hello irys"),
+		Tags:   Tags{{Name: "Content-Type", Value: "text/plain"}, {Name: "App-Name", Value: "irys-go"}},
+	}
+	item.Target[0] = 1
+	require.NoError(t, item.Sign(s))
+
+	reader, err := item.Reader()
+	require.NoError(t, err)
+	return reader.Bytes()
+}
+
+// _solana_data_item is data item of signature type 4 signed over hex of deep hash like HexSolanaSigner of arbundles,
+// it's created out of this sdk by script which follow arbundles createData (node crypto ed25519) with seed 0x0102..20,
+// anchor 32 bytes of 0x07, tags Content-Type: text/plain and App-Name: irys-go and data "hello irys"
+const _solana_data_item = "0400440d04597f3ae464f92aaaa1556f9a69a220bff927f2adbb687cf6d8c102fbf64207048e610f9081902a7d2e2abf7847d6b562" +
+	"196cb9df4534def253dc429d0979b5562e8fe654f94078b112e8a98ba7901f853ae695bed7e0e3910bad049664000107070707070707070707" +
+	"0707070707070707070707070707070707070707070702000000000000002b000000000000000418436f6e74656e742d5479706514746578742f70" +
+	"6c61696e104170702d4e616d650e697279732d676f0068656c6c6f2069727973"
+
+func TestBundleItem_SolanaVector(t *testing.T) {
+	raw, err := hex.DecodeString(_solana_data_item)
+	require.NoError(t, err)
+
+	decoded := new(BundleItem)
+	require.NoError(t, decoded.Unmarshal(raw))
+	require.Equal(t, signer.SOLANA, decoded.SignatureType)
+	require.NoError(t, decoded.VerifySignature())
+	require.Equal(t, "tzE-vSwXh0K8eY_53Qr9sBax3xUxTraWB2hshTgwrYo", decoded.Id.Base64())
+
+	// ed25519 is deterministic, so sdk must produce same signature for same item
+	s, err := signer.NewSolanaSigner("2Ana1pUpv2ZbMVkwF5FXapYeBEjdxDatLn7nvJkhgTSdZd8hbDHTd21as7EAsg7ypityqfsw2pMQKJcVDVcAEsd")
+	require.NoError(t, err)
+	require.Equal(t, s.Owner, []byte(decoded.Owner))
+
+	signature := decoded.Signature
+	decoded.Signature, decoded.Id, decoded.Owner = nil, nil, nil
+	require.NoError(t, decoded.Sign(s))
+	require.Equal(t, signature, decoded.Signature)
+}
+
+func TestBundleItem_RoundTrip(t *testing.T) {
+	raw := testSignedItem(t)
+
+	// bytes arrive one by one like slow network stream
+	decoded := new(BundleItem)
+	require.NoError(t, decoded.UnmarshalFromReader(iotest.OneByteReader(bytes.NewReader(raw))))
+	require.NoError(t, decoded.Verify())
+	require.NoError(t, decoded.VerifySignature())
+	require.Equal(t, Tags{{Name: "Content-Type", Value: "text/plain"}, {Name: "App-Name", Value: "irys-go"}}, decoded.Tags)
+	require.Equal(t, "hello irys", string(decoded.Data))
+
+	var encoded bytes.Buffer
+	require.NoError(t, decoded.Encode(&encoded))
+	require.Equal(t, raw, encoded.Bytes())
+}
+
+func TestBundleItem_UnmarshalInvalid(t *testing.T) {
+	raw := testSignedItem(t)
+	// signature type + signature + owner of solana
+	targetFlag := 2 + 64 + 32
+	anchorFlag := targetFlag + 1 + 32
+	numTags := anchorFlag + 1 + 32
+
+	// every truncation before data is rejected
+	for i := 0; i < numTags+16+1; i++ {
+		require.Error(t, new(BundleItem).Unmarshal(raw[:i]), i)
+	}
+
+	corrupt := func(offset int, value ...byte) []byte {
+		b := append([]byte{}, raw...)
+		copy(b[offset:], value)
+		return b
+	}
+
+	require.ErrorIs(t, new(BundleItem).Unmarshal(corrupt(targetFlag, 2)), errors.ErrInvalidTargetFlag)
+	require.ErrorIs(t, new(BundleItem).Unmarshal(corrupt(anchorFlag, 7)), errors.ErrInvalidAnchorFlag)
+	require.ErrorIs(t, new(BundleItem).Unmarshal(corrupt(numTags, 3)), errors.ErrTagsCountMismatch)
+	require.ErrorIs(t, new(BundleItem).Unmarshal(corrupt(numTags, 200)), errors.ErrVerifyTooManyTags)
+	require.ErrorIs(t, new(BundleItem).Unmarshal(corrupt(numTags+8, 0, 0, 1)), errors.ErrVerifyTooManyTagsBytes)
+	require.ErrorIs(t, new(BundleItem).Unmarshal(corrupt(numTags, 0)), errors.ErrTagsCountMismatch)
+
+	require.ErrorIs(t, new(BundleItem).Unmarshal(raw, WithMaxDataSize(4)), errors.ErrDataTooLarge)
+	require.NoError(t, new(BundleItem).Unmarshal(raw, WithMaxDataSize(10)))
+}
+
+func TestDecodeTags(t *testing.T) {
+	tags := Tags{{Name: "a", Value: "b"}, {Name: "Content-Type", Value: "text/plain"}}
+	encoded, err := tags.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := decodeTags(encoded)
+	require.NoError(t, err)
+	require.Equal(t, tags, decoded)
+
+	_, err = decodeTags(append(encoded, 0))
+	require.ErrorIs(t, err, errors.ErrInvalidTags)
+
+	_, err = decodeTags(encoded[:len(encoded)-2])
+	require.ErrorIs(t, err, errors.ErrInvalidTags)
+}
+
+func FuzzUnmarshal(f *testing.F) {
+	f.Add(testSignedItem(f))
+
+	f.Fuzz(func(t *testing.T, raw []byte) {
+		item := new(BundleItem)
+		if err := item.Unmarshal(raw, WithMaxDataSize(1<<20)); err != nil {
+			return
+		}
+
+		// decoded item must encode to same bytes
+		var encoded bytes.Buffer
+		require.NoError(t, item.Encode(&encoded))
+		require.Equal(t, raw, encoded.Bytes())
+	})
+}
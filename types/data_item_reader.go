@@ -0,0 +1,117 @@
+package types
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/verifier"
+)
+
+// DataItemReader is streaming data item decoder, header is decoded when reader is created and data is read
+// lazily from underlying reader, so large data items are never loaded in memory.
+//
+// Example:
+//
+//	item, err := types.NewDataItemReader(body)
+//	if err != nil {
+//		return err
+//	}
+//
+//	if _, err := io.Copy(file, item); err != nil {
+//		return err
+//	}
+//
+//	if err := item.VerifySignature(); err != nil {
+//		return err
+//	}
+type DataItemReader struct {
+	// Header is decoded data item without data
+	Header *BundleItem
+
+	data     io.Reader
+	blobHash hash.Hash
+	size     int64
+}
+
+// NewDataItemReader decode header of data item from reader, data is available by Read of returned reader
+func NewDataItemReader(reader io.Reader, opts ...DecodeOption) (*DataItemReader, error) {
+	options := newDecodeOptions(opts...)
+
+	header := new(BundleItem)
+	if err := header.decodeHeader(reader); err != nil {
+		return nil, err
+	}
+
+	return &DataItemReader{
+		Header:   header,
+		data:     newLimitedDataReader(reader, options.maxDataSize),
+		blobHash: sha512.New384(),
+	}, nil
+}
+
+// Read read data of data item, data is hashed while reading for VerifySignature
+func (d *DataItemReader) Read(p []byte) (int, error) {
+	n, err := d.data.Read(p)
+	d.blobHash.Write(p[:n])
+	d.size += int64(n)
+	return n, err
+}
+
+// Size return number of data bytes read so far
+func (d *DataItemReader) Size() int64 {
+	return d.size
+}
+
+// VerifySignature verify signature of data item, unread data is read and discarded before verification
+func (d *DataItemReader) VerifySignature() error {
+	if _, err := io.Copy(io.Discard, d); err != nil {
+		return err
+	}
+
+	// deep hash of data blob, tag is known only after whole data is read
+	tagHash := sha512.Sum384([]byte(fmt.Sprintf("blob%d", d.size)))
+	dataHash := sha512.Sum384(append(tagHash[:], d.blobHash.Sum(nil)...))
+
+	header := d.Header.signatureHeader()
+	listHash := sha512.Sum384([]byte(fmt.Sprintf("list%d", len(header)+1)))
+	acc := deepHashAcc(header, listHash)
+	deepHash := sha512.Sum384(append(acc[:], dataHash[:]...))
+
+	return verifier.Verify(d.Header.SignatureType, d.Header.Owner, deepHash[:], d.Header.Signature)
+}
+
+// limitedDataReader return ErrDataTooLarge when data is larger than limit
+type limitedDataReader struct {
+	reader io.Reader
+	remain int64
+}
+
+// newLimitedDataReader limit reader to max bytes, zero max is unlimited
+func newLimitedDataReader(reader io.Reader, max int64) io.Reader {
+	if max <= 0 {
+		return reader
+	}
+	return &limitedDataReader{reader: reader, remain: max}
+}
+
+func (l *limitedDataReader) Read(p []byte) (int, error) {
+	if l.remain <= 0 {
+		// one more byte tells data is larger than limit
+		var probe [1]byte
+		n, err := l.reader.Read(probe[:])
+		if n > 0 {
+			return 0, errors.ErrDataTooLarge
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > l.remain {
+		p = p[:l.remain]
+	}
+	n, err := l.reader.Read(p)
+	l.remain -= int64(n)
+	return n, err
+}
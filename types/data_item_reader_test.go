@@ -0,0 +1,42 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataItemReader(t *testing.T) {
+	raw := testSignedItem(t)
+
+	item, err := NewDataItemReader(iotest.HalfReader(bytes.NewReader(raw)))
+	require.NoError(t, err)
+	require.Len(t, item.Header.Tags, 2)
+	require.Empty(t, item.Header.Data)
+
+	data, err := io.ReadAll(item)
+	require.NoError(t, err)
+	require.Equal(t, "hello irys", string(data))
+	require.Equal(t, int64(len(data)), item.Size())
+	require.NoError(t, item.VerifySignature())
+
+	// data is read by verification when it's not consumed
+	item, err = NewDataItemReader(bytes.NewReader(raw))
+	require.NoError(t, err)
+	require.NoError(t, item.VerifySignature())
+
+	tampered := append([]byte{}, raw...)
+	tampered[len(tampered)-1] ^= 0xff
+	item, err = NewDataItemReader(bytes.NewReader(tampered))
+	require.NoError(t, err)
+	require.Error(t, item.VerifySignature())
+
+	item, err = NewDataItemReader(bytes.NewReader(raw), WithMaxDataSize(4))
+	require.NoError(t, err)
+	_, err = io.ReadAll(item)
+	require.ErrorIs(t, err, errors.ErrDataTooLarge)
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/Ja7ad/irys/errors"
+)
+
+// limits of ANS-104 data items
+const (
+	_max_tags             = 128
+	_max_tags_bytes       = 4096
+	_max_tag_name_length  = 1024
+	_max_tag_value_length = 3072
+)
+
+type decodeOptions struct {
+	maxDataSize int64
+}
+
+// DecodeOption is option of data item decoder
+type DecodeOption func(o *decodeOptions)
+
+// WithMaxDataSize limit size of data in decoded data item, default is unlimited
+func WithMaxDataSize(size int64) DecodeOption {
+	return func(o *decodeOptions) {
+		o.maxDataSize = size
+	}
+}
+
+func newDecodeOptions(opts ...DecodeOption) *decodeOptions {
+	o := new(decodeOptions)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// decodeTags decode avro array of tags, unlike avro library it reject trailing bytes and
+// check lengths before allocation because tags of untrusted data items are decoded.
+// https://avro.apache.org/docs/1.11.1/specification/#arrays-1
+func decodeTags(buf []byte) (Tags, error) {
+	tags := make(Tags, 0)
+
+	for {
+		count, n := binary.Varint(buf)
+		if n <= 0 {
+			return nil, errors.ErrInvalidTags
+		}
+		buf = buf[n:]
+
+		if count == 0 {
+			break
+		}
+
+		// negative count is followed by size of block in bytes
+		if count < 0 {
+			count = -count
+			if _, n = binary.Varint(buf); n <= 0 {
+				return nil, errors.ErrInvalidTags
+			}
+			buf = buf[n:]
+		}
+
+		if count > int64(_max_tags-len(tags)) {
+			return nil, errors.ErrVerifyTooManyTags
+		}
+
+		for i := int64(0); i < count; i++ {
+			var tag Tag
+			var err error
+			if tag.Name, buf, err = decodeAvroString(buf); err != nil {
+				return nil, err
+			}
+			if tag.Value, buf, err = decodeAvroString(buf); err != nil {
+				return nil, err
+			}
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(buf) != 0 {
+		return nil, errors.ErrInvalidTags
+	}
+
+	return tags, nil
+}
+
+func decodeAvroString(buf []byte) (string, []byte, error) {
+	length, n := binary.Varint(buf)
+	if n <= 0 || length < 0 || length > int64(len(buf)-n) {
+		return "", nil, errors.ErrInvalidTags
+	}
+	buf = buf[n:]
+	return string(buf[:length]), buf[length:], nil
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
 	"github.com/hamba/avro/v2"
 )
 
@@ -21,19 +24,85 @@ func (self Tags) Marshal() ([]byte, error) {
 	return avro.Marshal(avroParser, self)
 }
 
-func (self Tags) Unmarshal(data []byte) error {
-	return avro.Unmarshal(avroParser, data, &self)
-}
+// Unmarshal decode avro encoded tags into tags
+func (self *Tags) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*self = Tags{}
+		return nil
+	}
 
-func (self Tags) Size() int {
-	if len(self) == 0 {
-		return 0
+	tags, err := decodeTags(data)
+	if err != nil {
+		return err
 	}
 
-	data, _ := self.Marshal()
-	return len(data)
+	*self = tags
+	return nil
+}
+
+// Size return size of avro encoded tags
+func (self Tags) Size() (int, error) {
+	data, err := self.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
 }
 
 func (self Tags) Append(tags []Tag) Tags {
 	return append(self, tags...)
 }
+
+// Validate check tags against ANS-104 limits which are enforced by irys node, violation is returned as *ValidationError
+// https://github.com/ArweaveTeam/arweave-standards/blob/master/ans/ANS-104.md#21-verifying-a-dataitem
+func (self Tags) Validate() error {
+	if len(self) > _max_tags {
+		return newValidationError(FieldTags, -1, errors.ErrVerifyTooManyTags)
+	}
+
+	for i, tag := range self {
+		if len(tag.Name) == 0 {
+			return newValidationError(FieldTagName, i, errors.ErrVerifyEmptyTagName)
+		}
+		if len(tag.Name) > _max_tag_name_length {
+			return newValidationError(FieldTagName, i, errors.ErrVerifyTooLongTagName)
+		}
+		if len(tag.Value) == 0 {
+			return newValidationError(FieldTagValue, i, errors.ErrVerifyEmptyTagValue)
+		}
+		if len(tag.Value) > _max_tag_value_length {
+			return newValidationError(FieldTagValue, i, errors.ErrVerifyTooLongTagValue)
+		}
+	}
+
+	size, err := self.Size()
+	if err != nil {
+		return err
+	}
+	if size > _max_tags_bytes {
+		return newValidationError(FieldTags, -1, errors.ErrVerifyTooManyTagsBytes)
+	}
+
+	return nil
+}
+
+// Get return value of first tag with name, name is compared case-insensitive
+func (self Tags) Get(name string) (string, bool) {
+	for _, tag := range self {
+		if strings.EqualFold(tag.Name, name) {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// GetAll return values of all tags with name, name is compared case-insensitive
+func (self Tags) GetAll(name string) []string {
+	var values []string
+	for _, tag := range self {
+		if strings.EqualFold(tag.Name, name) {
+			values = append(values, tag.Value)
+		}
+	}
+	return values
+}
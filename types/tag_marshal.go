@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Ja7ad/irys/errors"
+)
+
+const _tag_key = "irys"
+
+// MarshalTags convert exported fields of struct to tags, name of tag is taken from `irys` field tag
+// (or field name) with optional omitempty, fields tagged "-" are skipped. supported field types are
+// strings, bools, numbers, encoding.TextMarshaler, fmt.Stringer and slices of them (one tag per item),
+// nil pointers are omitted.
+//
+// Example:
+//
+//	type Metadata struct {
+//		ContentType string   `irys:"Content-Type"`
+//		AppName     string   `irys:"App-Name,omitempty"`
+//		Version     int      `irys:"App-Version"`
+//		Topics      []string `irys:"Topic"`
+//	}
+//
+//	tags, err := types.MarshalTags(Metadata{ContentType: "image/png", Version: 2, Topics: []string{"a", "b"}})
+func MarshalTags(v any) (Tags, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil, errors.ErrUnsupportedTagValue
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, errors.ErrUnsupportedTagValue
+	}
+
+	tags := make(Tags, 0, value.NumField())
+	typ := value.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, omitEmpty := parseTagKey(field)
+		if name == "-" {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		if isNilPointer(fieldValue) || (omitEmpty && fieldValue.IsZero()) {
+			continue
+		}
+
+		if fieldValue.Kind() == reflect.Slice && fieldValue.Type().Elem().Kind() != reflect.Uint8 {
+			for j := 0; j < fieldValue.Len(); j++ {
+				if isNilPointer(fieldValue.Index(j)) {
+					continue
+				}
+				s, err := formatTagValue(fieldValue.Index(j))
+				if err != nil {
+					return nil, fmt.Errorf("field %s: %w", field.Name, err)
+				}
+				tags = append(tags, Tag{Name: name, Value: s})
+			}
+			continue
+		}
+
+		s, err := formatTagValue(fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		tags = append(tags, Tag{Name: name, Value: s})
+	}
+
+	return tags, nil
+}
+
+func parseTagKey(field reflect.StructField) (name string, omitEmpty bool) {
+	name = field.Name
+
+	key, ok := field.Tag.Lookup(_tag_key)
+	if !ok {
+		return
+	}
+
+	parts := strings.Split(key, ",")
+	if len(parts[0]) != 0 {
+		name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return
+}
+
+func isNilPointer(value reflect.Value) bool {
+	return value.Kind() == reflect.Pointer && value.IsNil()
+}
+
+func formatTagValue(value reflect.Value) (string, error) {
+	if value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+
+	switch v := value.Interface().(type) {
+	case encoding.TextMarshaler:
+		b, err := v.MarshalText()
+		return string(b), err
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits()), nil
+	default:
+		return "", errors.ErrUnsupportedTagValue
+	}
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTags_Unmarshal(t *testing.T) {
+	tags := Tags{{Name: "Content-Type", Value: "text/plain"}, {Name: "Topic", Value: "a"}}
+	encoded, err := tags.Marshal()
+	require.NoError(t, err)
+
+	var decoded Tags
+	require.NoError(t, decoded.Unmarshal(encoded))
+	require.Equal(t, tags, decoded)
+
+	size, err := tags.Size()
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), size)
+}
+
+func TestTags_Validate(t *testing.T) {
+	require.NoError(t, Tags{{Name: "a", Value: "b"}}.Validate())
+	require.ErrorIs(t, Tags{{Name: "", Value: "b"}}.Validate(), errors.ErrVerifyEmptyTagName)
+	require.ErrorIs(t, Tags{{Name: "a", Value: ""}}.Validate(), errors.ErrVerifyEmptyTagValue)
+	require.ErrorIs(t, Tags{{Name: strings.Repeat("a", 1025), Value: "b"}}.Validate(), errors.ErrVerifyTooLongTagName)
+	require.ErrorIs(t, Tags{{Name: "a", Value: strings.Repeat("b", 3073)}}.Validate(), errors.ErrVerifyTooLongTagValue)
+	require.ErrorIs(t, make(Tags, 129).Validate(), errors.ErrVerifyTooManyTags)
+
+	large := Tags{}
+	for i := 0; i < 3; i++ {
+		large = append(large, Tag{Name: "a", Value: strings.Repeat("b", 3000)})
+	}
+	require.ErrorIs(t, large.Validate(), errors.ErrVerifyTooManyTagsBytes)
+}
+
+func TestTags_Get(t *testing.T) {
+	tags := Tags{{Name: "Content-Type", Value: "text/plain"}, {Name: "Topic", Value: "a"}, {Name: "topic", Value: "b"}}
+
+	value, ok := tags.Get("content-type")
+	require.True(t, ok)
+	require.Equal(t, "text/plain", value)
+
+	_, ok = tags.Get("App-Name")
+	require.False(t, ok)
+
+	require.Equal(t, []string{"a", "b"}, tags.GetAll("TOPIC"))
+}
+
+func TestMarshalTags(t *testing.T) {
+	type metadata struct {
+		ContentType string        `irys:"Content-Type"`
+		AppName     string        `irys:"App-Name,omitempty"`
+		Version     int           `irys:"App-Version"`
+		Public      bool          `irys:"Public"`
+		Timeout     time.Duration `irys:"Timeout"`
+		Topics      []string      `irys:"Topic"`
+		Internal    string        `irys:"-"`
+		Title       string
+		secret      string
+	}
+
+	tags, err := MarshalTags(&metadata{
+		ContentType: "image/png",
+		Version:     2,
+		Public:      true,
+		Timeout:     time.Second,
+		Topics:      []string{"a", "b"},
+		Internal:    "skip",
+		Title:       "photo",
+		secret:      "skip",
+	})
+	require.NoError(t, err)
+	require.Equal(t, Tags{
+		{Name: "Content-Type", Value: "image/png"},
+		{Name: "App-Version", Value: "2"},
+		{Name: "Public", Value: "true"},
+		{Name: "Timeout", Value: "1s"},
+		{Name: "Topic", Value: "a"},
+		{Name: "Topic", Value: "b"},
+		{Name: "Title", Value: "photo"},
+	}, tags)
+
+	// nil pointers are omitted with or without omitempty
+	version := 3
+	tags, err = MarshalTags(struct {
+		Author  *string `irys:"Author"`
+		Version *int    `irys:"Version"`
+		Topics  []*int  `irys:"Topic"`
+	}{Version: &version, Topics: []*int{nil, &version}})
+	require.NoError(t, err)
+	require.Equal(t, Tags{{Name: "Version", Value: "3"}, {Name: "Topic", Value: "3"}}, tags)
+	require.NoError(t, tags.Validate())
+
+	_, err = MarshalTags("not struct")
+	require.ErrorIs(t, err, errors.ErrUnsupportedTagValue)
+
+	_, err = MarshalTags(struct{ Values map[string]string }{})
+	require.ErrorIs(t, err, errors.ErrUnsupportedTagValue)
+}
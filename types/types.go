@@ -91,6 +91,14 @@ type ReceiptResponse struct {
 	} `json:"data"`
 }
 
+type Quote struct {
+	Currency string   `json:"currency"`
+	DataSize int      `json:"data_size"` // DataSize is size of raw data in byte
+	ItemSize int      `json:"item_size"` // ItemSize is size of signed data item in byte, used for pricing
+	Atomic   *big.Int `json:"atomic"`    // Atomic is price in smallest unit of currency (wei, winston, ...)
+	Decimal  string   `json:"decimal"`   // Decimal is price in currency unit
+}
+
 type ChunkInfoResponse struct {
 	Chunks []int `json:"chunks"`
 	Total  int   `json:"total"`
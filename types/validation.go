@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/Ja7ad/irys/errors"
+)
+
+// fields of data item reported by ValidationError
+const (
+	FieldTags     = "tags"
+	FieldTagName  = "tag.name"
+	FieldTagValue = "tag.value"
+	FieldAnchor   = "anchor"
+	FieldTarget   = "target"
+	FieldSize     = "size"
+)
+
+// ValidationError is returned when data item violate ANS-104 or node limits, Err is sentinel error of
+// violated rule (e.g. errors.ErrVerifyTooLongTagValue) and Index is index of tag or -1 for other fields.
+type ValidationError struct {
+	Field string
+	Index int
+	Err   error
+}
+
+func (e *ValidationError) Error() string {
+	if e.Index >= 0 {
+		return fmt.Sprintf("invalid %s of tag %d: %s", e.Field, e.Index, e.Err)
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+func newValidationError(field string, index int, err error) *ValidationError {
+	return &ValidationError{Field: field, Index: index, Err: err}
+}
+
+// Validate check fields of data item which can be checked before signing (tags, anchor and target)
+func (self *BundleItem) Validate() error {
+	if len(self.Anchor) != 0 && len(self.Anchor) != 32 {
+		return newValidationError(FieldAnchor, -1, errors.ErrVerifyBadAnchorLength)
+	}
+	if len(self.Target) != 0 && len(self.Target) != 32 {
+		return newValidationError(FieldTarget, -1, errors.ErrVerifyBadTargetLength)
+	}
+	return self.Tags.Validate()
+}
+
+// ValidateSize check size of signed data item is not larger than max size, zero max size is unlimited
+func ValidateSize(size, maxSize int) error {
+	if maxSize > 0 && size > maxSize {
+		return newValidationError(FieldSize, -1, errors.ErrDataItemTooLarge)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleItem_Validate(t *testing.T) {
+	item := BundleItem{Tags: Tags{{Name: "a", Value: "b"}}, Anchor: make([]byte, 32)}
+	require.NoError(t, item.Validate())
+
+	item.Anchor = make([]byte, 16)
+	var vErr *ValidationError
+	require.ErrorAs(t, item.Validate(), &vErr)
+	require.Equal(t, FieldAnchor, vErr.Field)
+	require.ErrorIs(t, vErr, errors.ErrVerifyBadAnchorLength)
+
+	item.Anchor = nil
+	item.Target = make([]byte, 31)
+	require.ErrorIs(t, item.Validate(), errors.ErrVerifyBadTargetLength)
+
+	item.Target = nil
+	item.Tags = Tags{{Name: "a", Value: "b"}, {Name: "c", Value: ""}}
+	require.ErrorAs(t, item.Validate(), &vErr)
+	require.Equal(t, FieldTagValue, vErr.Field)
+	require.Equal(t, 1, vErr.Index)
+	require.ErrorIs(t, vErr, errors.ErrVerifyEmptyTagValue)
+	require.Equal(t, "invalid tag.value of tag 1: tag value is empty", vErr.Error())
+}
+
+func TestValidateSize(t *testing.T) {
+	require.NoError(t, ValidateSize(100, 0))
+	require.NoError(t, ValidateSize(100, 100))
+
+	var vErr *ValidationError
+	require.ErrorAs(t, ValidateSize(101, 100), &vErr)
+	require.Equal(t, FieldSize, vErr.Field)
+	require.ErrorIs(t, vErr, errors.ErrDataItemTooLarge)
+}
@@ -0,0 +1,71 @@
+// Package base58 implement bitcoin alphabet base58 encoding used by solana addresses and keys
+package base58
+
+import (
+	"errors"
+	"math/big"
+)
+
+const _alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var ErrInvalidCharacter = errors.New("invalid base58 character")
+
+var (
+	_radix   = big.NewInt(58)
+	_indexes [256]int
+)
+
+func init() {
+	for i := range _indexes {
+		_indexes[i] = -1
+	}
+	for i := 0; i < len(_alphabet); i++ {
+		_indexes[_alphabet[i]] = i
+	}
+}
+
+// Encode return base58 string of data
+func Encode(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	n := new(big.Int).SetBytes(data)
+	mod := new(big.Int)
+	out := make([]byte, 0, len(data)*138/100+1)
+	for n.Sign() > 0 {
+		n.DivMod(n, _radix, mod)
+		out = append(out, _alphabet[mod.Int64()])
+	}
+
+	for i := 0; i < zeros; i++ {
+		out = append(out, _alphabet[0])
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return string(out)
+}
+
+// Decode return bytes of base58 string
+func Decode(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == _alphabet[0] {
+		zeros++
+	}
+
+	n := new(big.Int)
+	for i := 0; i < len(s); i++ {
+		idx := _indexes[s[i]]
+		if idx < 0 {
+			return nil, ErrInvalidCharacter
+		}
+		n.Mul(n, _radix)
+		n.Add(n, big.NewInt(int64(idx)))
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
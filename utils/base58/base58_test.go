@@ -0,0 +1,36 @@
+package base58
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		hex     string
+		encoded string
+	}{
+		{"", ""},
+		{"00", "1"},
+		{"0000", "11"},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"0000000000000000000000000000000000000000000000000000000000000000", "11111111111111111111111111111111"},
+		{"73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+	}
+
+	for _, tt := range tests {
+		data, err := hex.DecodeString(tt.hex)
+		require.NoError(t, err)
+		require.Equal(t, tt.encoded, Encode(data))
+
+		decoded, err := Decode(tt.encoded)
+		require.NoError(t, err)
+		require.Equal(t, data, decoded)
+	}
+
+	_, err := Decode("0OIl")
+	require.ErrorIs(t, err, ErrInvalidCharacter)
+}
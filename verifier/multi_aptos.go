@@ -0,0 +1,43 @@
+package verifier
+
+import (
+	"crypto/ed25519"
+
+	"github.com/Ja7ad/irys/errors"
+)
+
+const (
+	_multi_aptos_max_keys         = 32
+	_multi_aptos_bitmap_length    = 4
+	_multi_aptos_signature_length = _multi_aptos_max_keys*ed25519.SignatureSize + _multi_aptos_bitmap_length // 2052
+	_multi_aptos_owner_length     = _multi_aptos_max_keys*ed25519.PublicKeySize + 1                          // 1025
+)
+
+// verifyMultiAptos verify aptos multi-ed25519 signature, owner is 32 public keys followed by threshold and
+// signature is 32 signature slots followed by bitmap of signed keys (most significant bit first).
+// unlike injected aptos, signatures are over raw message. every signature marked in bitmap must be valid
+// and count of them must reach threshold.
+func verifyMultiAptos(owner, message, signature []byte) error {
+	threshold := int(owner[_multi_aptos_owner_length-1])
+	bitmap := signature[_multi_aptos_signature_length-_multi_aptos_bitmap_length:]
+
+	signed := 0
+	for i := 0; i < _multi_aptos_max_keys; i++ {
+		if bitmap[i/8]&(1<<(7-i%8)) == 0 {
+			continue
+		}
+
+		publicKey := owner[i*ed25519.PublicKeySize : (i+1)*ed25519.PublicKeySize]
+		sig := signature[i*ed25519.SignatureSize : (i+1)*ed25519.SignatureSize]
+		if !ed25519.Verify(publicKey, message, sig) {
+			return errors.ErrEd25519SignatureMismatch
+		}
+		signed++
+	}
+
+	if signed == 0 || signed < threshold {
+		return errors.ErrMultiSignatureThreshold
+	}
+
+	return nil
+}
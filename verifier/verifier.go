@@ -0,0 +1,127 @@
+// Package verifier verify signatures of ANS-104 data items for all signature types without private keys.
+// see https://github.com/ArweaveTeam/arweave-standards/blob/master/ans/ANS-104.md
+package verifier
+
+import (
+	"crypto/ed25519"
+	"sort"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+)
+
+// Config is lengths and verify function of signature type
+type Config struct {
+	SignatureLength int
+	OwnerLength     int
+	Verify          func(owner, message, signature []byte) error
+}
+
+// values are taken from bundlr library
+// https://github.com/Irys-xyz/arbundles/blob/master/src/constants.ts
+var _configs = map[signer.SignatureType]Config{
+	signer.Arweave: {
+		SignatureLength: 512,
+		OwnerLength:     512,
+		Verify: func(owner, message, signature []byte) error {
+			return (&signer.ArweaveSigner{Owner: owner}).Verify(message, signature)
+		},
+	},
+	signer.ED25519: {
+		SignatureLength: ed25519.SignatureSize,
+		OwnerLength:     ed25519.PublicKeySize,
+		Verify:          verifyEd25519,
+	},
+	signer.Ethereum: {
+		SignatureLength: 65,
+		OwnerLength:     65,
+		Verify: func(owner, message, signature []byte) error {
+			return (&signer.EthereumSigner{Owner: owner}).Verify(message, signature)
+		},
+	},
+	signer.SOLANA: {
+		SignatureLength: ed25519.SignatureSize,
+		OwnerLength:     ed25519.PublicKeySize,
+		Verify: func(owner, message, signature []byte) error {
+			return verifyEd25519(owner, signer.SolanaMessage(message), signature)
+		},
+	},
+	signer.APTOS: {
+		SignatureLength: ed25519.SignatureSize,
+		OwnerLength:     ed25519.PublicKeySize,
+		Verify: func(owner, message, signature []byte) error {
+			return verifyEd25519(owner, signer.AptosMessage(message), signature)
+		},
+	},
+	signer.MULTI_APTOS: {
+		SignatureLength: _multi_aptos_signature_length,
+		OwnerLength:     _multi_aptos_owner_length,
+		Verify:          verifyMultiAptos,
+	},
+	signer.KYVE: {
+		SignatureLength: 65,
+		OwnerLength:     65,
+		Verify: func(owner, message, signature []byte) error {
+			return (&signer.EthereumSigner{Owner: owner}).Verify(message, signature)
+		},
+	},
+	signer.TYPED_ETHEREUM: {
+		SignatureLength: 65,
+		OwnerLength:     42,
+		Verify: func(owner, message, signature []byte) error {
+			return (&signer.TypedEthereumSigner{Owner: owner}).Verify(message, signature)
+		},
+	},
+}
+
+// GetConfig return config of signature type
+func GetConfig(signatureType signer.SignatureType) (Config, error) {
+	config, ok := _configs[signatureType]
+	if !ok {
+		return Config{}, errors.ErrUnsupportedSignatureType
+	}
+	return config, nil
+}
+
+// Lengths return signature and owner length of signature type
+func Lengths(signatureType signer.SignatureType) (signatureLength, ownerLength int, err error) {
+	config, err := GetConfig(signatureType)
+	if err != nil {
+		return 0, 0, err
+	}
+	return config.SignatureLength, config.OwnerLength, nil
+}
+
+// Verify check signature of message is signed by owner with signature type
+func Verify(signatureType signer.SignatureType, owner, message, signature []byte) error {
+	config, err := GetConfig(signatureType)
+	if err != nil {
+		return err
+	}
+
+	if len(signature) != config.SignatureLength {
+		return errors.ErrInvalidSignatureLength
+	}
+	if len(owner) != config.OwnerLength {
+		return errors.ErrInvalidOwnerLength
+	}
+
+	return config.Verify(owner, message, signature)
+}
+
+// Supported return sorted signature types supported by verifier
+func Supported() []signer.SignatureType {
+	types := make([]signer.SignatureType, 0, len(_configs))
+	for t := range _configs {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+func verifyEd25519(owner, message, signature []byte) error {
+	if !ed25519.Verify(owner, message, signature) {
+		return errors.ErrEd25519SignatureMismatch
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package verifier
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/Ja7ad/irys/errors"
+	"github.com/Ja7ad/irys/signer"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_test_ethereum_private_key = "0x8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f"
+	_test_solana_private_key   = "2Ana1pUpv2ZbMVkwF5FXapYeBEjdxDatLn7nvJkhgTSdZd8hbDHTd21as7EAsg7ypityqfsw2pMQKJcVDVcAEsd"
+	_test_aptos_private_key    = "0x0b1fd9bd37ab4ad03f6fe5c17a1b1ad0e3a7e8a8a2ad34b2bd5bb2a1cc1e7d3f"
+)
+
+func TestVerify(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed, err := signer.NewEd25519Signer(edKey)
+	require.NoError(t, err)
+	eth, err := signer.NewEthereumSigner(_test_ethereum_private_key)
+	require.NoError(t, err)
+	typed, err := signer.NewTypedEthereumSigner(_test_ethereum_private_key)
+	require.NoError(t, err)
+	sol, err := signer.NewSolanaSigner(_test_solana_private_key)
+	require.NoError(t, err)
+	aptos, err := signer.NewAptosSigner(_test_aptos_private_key)
+	require.NoError(t, err)
+
+	message := []byte("to be signed")
+
+	for _, s := range []signer.Signer{ed, eth, typed, sol, aptos} {
+		signature, err := s.Sign(message)
+		require.NoError(t, err)
+		owner, err := s.GetOwner()
+		require.NoError(t, err)
+
+		signatureLength, ownerLength, err := Lengths(s.GetType())
+		require.NoError(t, err)
+		require.Equal(t, s.GetSignatureLength(), signatureLength)
+		require.Equal(t, s.GetOwnerLength(), ownerLength)
+
+		require.NoError(t, Verify(s.GetType(), owner, message, signature), s.GetType())
+		require.Error(t, Verify(s.GetType(), owner, []byte("other data"), signature), s.GetType())
+		require.ErrorIs(t, Verify(s.GetType(), owner[1:], message, signature), errors.ErrInvalidOwnerLength)
+		require.ErrorIs(t, Verify(s.GetType(), owner, message, signature[1:]), errors.ErrInvalidSignatureLength)
+	}
+
+	require.ErrorIs(t, Verify(signer.SignatureType(99), nil, message, nil), errors.ErrUnsupportedSignatureType)
+}
+
+func TestVerifySolanaHexMessage(t *testing.T) {
+	sol, err := signer.NewSolanaSigner(_test_solana_private_key)
+	require.NoError(t, err)
+
+	message := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	// solana browser wallets (HexInjectedSolanaSigner of arbundles) sign hex encoding of message
+	signature := ed25519.Sign(sol.PrivateKey, []byte("deadbeef"))
+	require.NoError(t, Verify(signer.SOLANA, sol.Owner, message, signature))
+
+	raw := ed25519.Sign(sol.PrivateKey, message)
+	require.ErrorIs(t, Verify(signer.SOLANA, sol.Owner, message, raw), errors.ErrEd25519SignatureMismatch)
+}
+
+func TestVerifyKyve(t *testing.T) {
+	eth, err := signer.NewEthereumSigner(_test_ethereum_private_key)
+	require.NoError(t, err)
+
+	message := []byte("to be signed")
+	signature, err := eth.Sign(message)
+	require.NoError(t, err)
+
+	signatureLength, ownerLength, err := Lengths(signer.KYVE)
+	require.NoError(t, err)
+	require.Equal(t, 65, signatureLength)
+	require.Equal(t, 65, ownerLength)
+
+	owner, err := eth.GetOwner()
+	require.NoError(t, err)
+	require.NoError(t, Verify(signer.KYVE, owner, message, signature))
+	require.Error(t, Verify(signer.KYVE, owner, []byte("other data"), signature))
+	require.Contains(t, Supported(), signer.KYVE)
+}
+
+func TestVerifyMultiAptos(t *testing.T) {
+	message := []byte("to be signed")
+
+	owner := make([]byte, _multi_aptos_owner_length)
+	signature := make([]byte, _multi_aptos_signature_length)
+	bitmap := signature[_multi_aptos_signature_length-_multi_aptos_bitmap_length:]
+	owner[_multi_aptos_owner_length-1] = 2 // threshold
+
+	// keys 0 and 9 sign
+	for _, i := range []int{0, 9} {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		copy(owner[i*ed25519.PublicKeySize:], pub)
+		copy(signature[i*ed25519.SignatureSize:], ed25519.Sign(priv, message))
+		bitmap[i/8] |= 1 << (7 - i%8)
+	}
+
+	require.NoError(t, Verify(signer.MULTI_APTOS, owner, message, signature))
+	require.ErrorIs(t, Verify(signer.MULTI_APTOS, owner, []byte("other data"), signature), errors.ErrEd25519SignatureMismatch)
+
+	owner[_multi_aptos_owner_length-1] = 3
+	require.ErrorIs(t, Verify(signer.MULTI_APTOS, owner, message, signature), errors.ErrMultiSignatureThreshold)
+}